@@ -1,10 +1,16 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"restaurant-concurrency/internal/adapter/primary/ui"
+	"restaurant-concurrency/internal/domain/model"
 	"restaurant-concurrency/internal/domain/service"
 	infrastructure "restaurant-concurrency/internal/infraestructure"
 
@@ -18,15 +24,33 @@ const (
 	numCocineros   = 1 // 1 chef produciendo platos
 	numMeseros     = 1 // 1 mesero controlado por el jugador
 	numMesas       = 3 // 8 mesas con clientes
+
+	// Turno de servicio (si no hay archivos de nivel)
+	duracionTurno = 3 * time.Minute
+	metaDinero    = 300.0 // Dinero a juntar para ganar el turno
+	maxPerdidos   = 10    // Clientes perdidos antes del game over
+
+	directorioNiveles = "niveles"
+	archivoConfig     = "config.json" // Perfil de controles
 )
 
 func main() {
 
+	// Cargar niveles de dificultad
+	niveles, err := infrastructure.CargarNiveles(directorioNiveles)
+	if err != nil {
+		log.Fatalf("Error al cargar niveles: %v", err)
+	}
+	if len(niveles) == 0 {
+		niveles = []model.Nivel{nivelLibre()}
+	}
+
 	// Configuración inicial
 	fmt.Println("CONFIGURACION:")
-	fmt.Printf("   • Cocineros (productores): %d\n", numCocineros)
-	fmt.Printf("   • Capacidad de barra (buffer): %d\n", capacidadBarra)
-	fmt.Printf("   • Mesas con clientes: %d\n", numMesas)
+	fmt.Printf("   • Niveles disponibles: %d (inicial: %s)\n", len(niveles), niveles[0].Nombre)
+	fmt.Printf("   • Cocineros (productores): %d\n", niveles[0].NumCocineros)
+	fmt.Printf("   • Capacidad de barra (buffer): %d\n", niveles[0].CapacidadBarra)
+	fmt.Printf("   • Mesas con clientes: %d\n", niveles[0].NumMesas)
 	fmt.Printf("   • Resolución: %dx%d\n", screenWidth, screenHeight)
 	fmt.Println()
 
@@ -43,11 +67,7 @@ func main() {
 
 	// Crear servicio del restaurante
 	fmt.Println("Inicializando servicio del restaurante...")
-	restaurantService := service.NewRestaurantService(
-		capacidadBarra,
-		numCocineros,
-		numMesas,
-	)
+	restaurantService := service.NewRestaurantServiceConNivel(niveles[0])
 
 	// Iniciar las goroutines concurrentes
 	// - Cocineros (productores automáticos)
@@ -55,27 +75,89 @@ func main() {
 	restaurantService.Start()
 	logger.Info("Sistema de concurrencia iniciado")
 
+	// SIGINT/SIGTERM activan el mismo cierre ordenado que Q/ESC
+	senales := make(chan os.Signal, 1)
+	signal.Notify(senales, syscall.SIGINT, syscall.SIGTERM)
+	go cerrarAlRecibirSenal(senales, restaurantService)
+
 	// Crear el juego con Ebiten
 	fmt.Println("Inicializando interfaz gráfica...")
-	game, err := ui.NewGame(restaurantService, screenWidth, screenHeight)
+	game, err := ui.NewGame(restaurantService, niveles, screenWidth, screenHeight)
 	if err != nil {
 		log.Fatalf("Error al crear el juego: %v", err)
 	}
 	logger.Info("Interfaz gráfica inicializada")
 
+	// Teclas personalizadas (si el perfil es inválido se usan las de por defecto)
+	if err := game.SetAlmacenControles(infrastructure.NewArchivoControles(archivoConfig)); err != nil {
+		logger.Warnf("Perfil de controles inválido, usando controles por defecto: %v", err)
+	}
+
 	// Configurar ventana
 	ebiten.SetWindowSize(screenWidth, screenHeight)
 	ebiten.SetWindowTitle("Restaurante Concurrente - Arquitectura Hexagonal")
 	ebiten.SetWindowResizingMode(ebiten.WindowResizingModeEnabled)
 	ebiten.SetTPS(60) // 60 FPS
 
-	if err := ebiten.RunGame(game); err != nil {
-		if err.Error() != "cierre solicitado por usuario" {
-			log.Println("Error durante la ejecución:", err)
-		}
+	errEjecucion := ebiten.RunGame(game)
+	if errEjecucion != nil && !errors.Is(errEjecucion, ui.ErrCierreSolicitado) {
+		log.Println("Error durante la ejecución:", errEjecucion)
 	}
 
 	// ============ CIERRE ORDENADO ============
-	restaurantService.Close()
+	signal.Stop(senales)
+	reporte := restaurantService.Close()
+	if reporte.PorPlazo {
+		logger.Warn("El cierre se cortó por el plazo")
+	}
+	imprimirResumenSesion(restaurantService, reporte)
 	logger.Info("Sistema cerrado correctamente")
+
+	if errEjecucion != nil && !errors.Is(errEjecucion, ui.ErrCierreSolicitado) {
+		os.Exit(1)
+	}
+}
+
+// imprimirResumenSesion muestra las métricas finales de la sesión
+func imprimirResumenSesion(restaurantService *service.RestaurantService, reporte model.ReporteCierre) {
+	contabilidad := restaurantService.GetContabilidad()
+	_, _, perdidos := restaurantService.GetMetricas()
+	dinero, propinas, satisfaccion := restaurantService.GetEconomia()
+
+	fmt.Println()
+	fmt.Println("RESUMEN DE LA SESION:")
+	fmt.Printf("   • Platos producidos: %d (recogidos: %d)\n", contabilidad.Producidos, contabilidad.Recogidos)
+	fmt.Printf("   • Platos servidos: %d\n", contabilidad.Entregados)
+	fmt.Printf("   • Clientes perdidos: %d\n", perdidos)
+	fmt.Printf("   • Dinero: $%.2f (propinas: $%.2f)\n", dinero, propinas)
+	fmt.Printf("   • Satisfacción promedio: %.0f%%\n", satisfaccion*100)
+	fmt.Printf("   • Platos desperdiciados: %d (barra: %d, cocina: %d, en mano: %d)\n",
+		reporte.Desperdiciados(), reporte.EnBarra, reporte.EnCocina, reporte.EnMano)
+	fmt.Printf("   • Clientes sin atender al cerrar: %d\n", reporte.ClientesSinAtender)
+}
+
+// cerrarAlRecibirSenal inicia el cierre ordenado cuando llega una señal del sistema
+// Una segunda señal sale en el acto, sin esperar a que termine el cierre
+func cerrarAlRecibirSenal(senales <-chan os.Signal, restaurantService *service.RestaurantService) {
+	senal := <-senales
+	fmt.Printf("Señal %v recibida: cerrando el restaurante (otra señal sale sin esperar)...\n", senal)
+	// En pausa el plazo del cierre no corre: se reanuda para que el cierre termine
+	restaurantService.Reanudar()
+	restaurantService.IniciarCierre(service.PlazoCierre)
+
+	senal = <-senales
+	fmt.Printf("Señal %v recibida otra vez: saliendo sin terminar el cierre\n", senal)
+	os.Exit(1)
+}
+
+// nivelLibre arma un nivel con las constantes de este archivo (cuando no hay niveles en disco)
+func nivelLibre() model.Nivel {
+	nivel := model.NivelPorDefecto()
+	nivel.CapacidadBarra = capacidadBarra
+	nivel.NumCocineros = numCocineros
+	nivel.NumMesas = numMesas
+	nivel.DuracionTurno = duracionTurno
+	nivel.MetaDinero = metaDinero
+	nivel.MaxPerdidos = maxPerdidos
+	return nivel
 }
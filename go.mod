@@ -5,6 +5,7 @@ go 1.24.2
 require (
 	github.com/hajimehoshi/ebiten/v2 v2.7.0
 	github.com/rs/zerolog v1.31.0
+	golang.org/x/sync v0.6.0
 )
 
 require (
@@ -14,6 +15,5 @@ require (
 	github.com/jezek/xgb v1.1.1 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.19 // indirect
-	golang.org/x/sync v0.6.0 // indirect
 	golang.org/x/sys v0.18.0 // indirect
 )
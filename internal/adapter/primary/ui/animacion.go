@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"restaurant-concurrency/internal/domain/model"
 	"time"
 )
 
@@ -24,8 +25,11 @@ type MeseroAnimado struct {
 	DestinoX, DestinoY float64 // Posición destino
 	Velocidad          float64 // Pixels por segundo
 	TiempoInicio       time.Time
-	TieneAlgo          bool // Si lleva un plato
-	PlatoID            int  // ID del plato que lleva
+	TieneAlgo          bool          // Si lleva un plato
+	PlatoID            int           // ID del plato que lleva
+	Ruta               []model.Punto // Puntos que faltan recorrer hasta el destino
+
+	mapa *model.Mapa // Obstáculos para buscar rutas (nil = línea recta)
 }
 
 // NewMeseroAnimado crea un nuevo mesero animado
@@ -41,13 +45,41 @@ func NewMeseroAnimado(id int, x, y float64) *MeseroAnimado {
 	}
 }
 
-// IrABarra hace que el mesero vaya a la barra
-func (m *MeseroAnimado) IrABarra(barraX, barraY float64) {
+// SetMapa asigna el mapa con el que el mesero esquiva mesas y barra
+func (m *MeseroAnimado) SetMapa(mapa *model.Mapa) {
+	m.mapa = mapa
+}
+
+// irHacia fija el destino y calcula la ruta con A* si hay mapa
+// Sin camino no cruza obstáculos en línea recta: se corre a la celda libre más cercana
+// (por si quedó encima de un obstáculo) y retorna false para que se abandone la tarea
+func (m *MeseroAnimado) irHacia(x, y float64) bool {
+	m.DestinoX = x
+	m.DestinoY = y
+	m.Ruta = nil
+	if m.mapa == nil {
+		return true
+	}
+	ruta := m.mapa.Ruta(m.X, m.Y, x, y)
+	if ruta == nil {
+		m.DestinoX, m.DestinoY = m.X, m.Y
+		if libre, ok := m.mapa.PuntoLibreCercano(m.X, m.Y, m.mapa.Libre); ok {
+			m.DestinoX, m.DestinoY = libre.X, libre.Y
+		}
+		return false
+	}
+	m.Ruta = ruta
+	destino := ruta[len(ruta)-1]
+	m.DestinoX, m.DestinoY = destino.X, destino.Y
+	return true
+}
+
+// IrABarra hace que el mesero vaya a la barra; retorna false si no hay camino
+func (m *MeseroAnimado) IrABarra(barraX, barraY float64) bool {
 	m.Estado = MeseroYendoABarra
-	m.DestinoX = barraX
-	m.DestinoY = barraY
 	m.TiempoInicio = time.Now()
 	m.TieneAlgo = false
+	return m.irHacia(barraX, barraY)
 }
 
 // TomarPlato simula que el mesero toma un plato
@@ -58,19 +90,17 @@ func (m *MeseroAnimado) TomarPlato(platoID int) {
 	m.TiempoInicio = time.Now()
 }
 
-// LlevarACliente hace que el mesero lleve el plato al cliente
-func (m *MeseroAnimado) LlevarACliente(clienteX, clienteY float64) {
+// LlevarACliente hace que el mesero lleve el plato al cliente; retorna false si no hay camino
+func (m *MeseroAnimado) LlevarACliente(clienteX, clienteY float64) bool {
 	m.Estado = MeseroLlevandoACliente
-	m.DestinoX = clienteX
-	m.DestinoY = clienteY
 	m.TiempoInicio = time.Now()
+	return m.irHacia(clienteX, clienteY)
 }
 
 // Regresar hace que el mesero regrese a su posición inicial
 func (m *MeseroAnimado) Regresar() {
 	m.Estado = MeseroRegresando
-	m.DestinoX = m.InicioX
-	m.DestinoY = m.InicioY
+	m.irHacia(m.InicioX, m.InicioY)
 	m.TiempoInicio = time.Now()
 	m.TieneAlgo = false
 }
@@ -79,12 +109,22 @@ func (m *MeseroAnimado) Regresar() {
 func (m *MeseroAnimado) Actualizar(deltaTime float64) {
 	switch m.Estado {
 	case MeseroYendoABarra, MeseroLlevandoACliente, MeseroRegresando:
-		// Calcular dirección
-		dx := m.DestinoX - m.X
-		dy := m.DestinoY - m.Y
+		// Con ruta, avanzar punto por punto; sin ruta, en línea recta al destino
+		haciaX, haciaY := m.DestinoX, m.DestinoY
+		if len(m.Ruta) > 0 {
+			haciaX, haciaY = m.Ruta[0].X, m.Ruta[0].Y
+		}
+		dx := haciaX - m.X
+		dy := haciaY - m.Y
 		distancia := sqrt(dx*dx + dy*dy)
 
-		if distancia < 2.0 {
+		if distancia < 2.0 && len(m.Ruta) > 1 {
+			// Llegó a un punto intermedio
+			m.X, m.Y = haciaX, haciaY
+			m.Ruta = m.Ruta[1:]
+		} else if distancia < 2.0 {
+			m.Ruta = nil
+
 			// Llegó al destino
 			m.X = m.DestinoX
 			m.Y = m.DestinoY
@@ -0,0 +1,231 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"math/rand"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// rolJugador es el lado del patrón productor-consumidor que controla el jugador
+type rolJugador int
+
+const (
+	rolMesero   rolJugador = iota // Consume de la barra (cocineros automáticos)
+	rolCocinero                   // Produce en la barra (meseros automáticos)
+)
+
+func (r rolJugador) String() string {
+	if r == rolCocinero {
+		return "Cocinero (meseros automaticos)"
+	}
+	return "Mesero"
+}
+
+// Estaciones de la cocina del jugador (a la derecha de la barra)
+const (
+	numEstaciones      = 3
+	estacionX          = 1200.0
+	estacionY          = 60.0
+	anchoEstacion      = 110.0
+	altoEstacion       = 70.0
+	separacionEstacion = 130.0
+	ventanaPunto       = 0.35 // Fracción de la cocción en la que el plato está a punto
+	ventanaPerfecta    = 0.12 // Primera parte de la ventana: emplatado perfecto
+)
+
+// estadoEstacion es lo que pasa en una estación de cocina
+type estadoEstacion int
+
+const (
+	estacionLibre     estadoEstacion = iota
+	estacionCocinando                // El plato se cocina; hay que emplatarlo a punto
+	estacionEsperando                // Plato listo esperando lugar en la barra (productor bloqueado)
+)
+
+// estacion es un fuego de la cocina
+type estacion struct {
+	Estado  estadoEstacion
+	Inicio  time.Time     // Inicio de la cocción (reloj del juego)
+	Coccion time.Duration // Tiempo hasta que el plato está a punto
+	Receta  string        // Receta en preparación (vacía si el nivel no usa despensa)
+}
+
+// progreso retorna la fracción de la cocción transcurrida (1 = a punto)
+func (e *estacion) progreso(ahora time.Time) float64 {
+	if e.Coccion <= 0 {
+		return 1
+	}
+	return float64(ahora.Sub(e.Inicio)) / float64(e.Coccion)
+}
+
+// cocinaJugador es la cocina que maneja el jugador en el rol de cocinero
+type cocinaJugador struct {
+	estaciones []*estacion
+	actual     int // Estación frente a la que está el cocinero
+
+	// Puntaje del turno
+	Emplatados int
+	Perfectos  int
+	Quemados   int
+}
+
+func newCocinaJugador() *cocinaJugador {
+	estaciones := make([]*estacion, numEstaciones)
+	for i := range estaciones {
+		estaciones[i] = &estacion{}
+	}
+	return &cocinaJugador{estaciones: estaciones}
+}
+
+// posicionEstacion retorna la esquina superior izquierda de una estación
+func posicionEstacion(i int) (x, y float64) {
+	return estacionX + float64(i)*separacionEstacion, estacionY
+}
+
+// actualizarCocina mueve al cocinero entre estaciones, cocina, emplata y quema platos
+func (g *Game) actualizarCocina() {
+	cocina := g.cocina
+	ahora := g.service.Ahora()
+
+	if g.inputHandler.IsActionJustPressed(ActionIzquierda) && cocina.actual > 0 {
+		cocina.actual--
+	}
+	if g.inputHandler.IsActionJustPressed(ActionDerecha) && cocina.actual < len(cocina.estaciones)-1 {
+		cocina.actual++
+	}
+
+	// Pasada la ventana, el plato se quema solo
+	for _, e := range cocina.estaciones {
+		if e.Estado == estacionCocinando && e.progreso(ahora) > 1+ventanaPunto {
+			e.Estado = estacionLibre
+			cocina.Quemados++
+			g.mostrarNotificacion("Se quemo un plato!")
+		}
+	}
+
+	if g.inputHandler.IsActionJustPressed(ActionRecoger) || g.inputHandler.IsActionJustPressed(ActionEntregar) {
+		g.usarEstacion(cocina.estaciones[cocina.actual], ahora)
+	}
+}
+
+// usarEstacion empieza a cocinar, emplata o reintenta dejar el plato en la barra
+func (g *Game) usarEstacion(e *estacion, ahora time.Time) {
+	cocina := g.cocina
+
+	switch e.Estado {
+	case estacionLibre:
+		if cerrando, _ := g.service.GetCierre(); cerrando {
+			g.mostrarNotificacion("Hora de cerrar: no se empiezan platos nuevos")
+			return
+		}
+		receta, ok := g.service.TomarIngredientes()
+		if !ok {
+			g.mostrarNotificacion("Faltan ingredientes: espera al proveedor")
+			return
+		}
+		nivel := g.niveles[g.nivelActual]
+		e.Receta = receta
+		e.Coccion = nivel.CoccionBase
+		if nivel.CoccionVariacion > 0 {
+			e.Coccion += time.Duration(rand.Int63n(int64(nivel.CoccionVariacion)))
+		}
+		e.Inicio = ahora
+		e.Estado = estacionCocinando
+
+	case estacionCocinando:
+		progreso := e.progreso(ahora)
+		if progreso < 1 {
+			g.mostrarNotificacion("Todavia crudo: espera la zona verde")
+			return
+		}
+		if progreso <= 1+ventanaPerfecta {
+			cocina.Perfectos++
+			g.mostrarNotificacion("Perfecto!")
+		}
+		cocina.Emplatados++
+		e.Estado = estacionEsperando
+		g.dejarEnBarra(e)
+
+	case estacionEsperando:
+		g.dejarEnBarra(e)
+	}
+}
+
+// dejarEnBarra intenta poner el plato emplatado en la barra (el punto de entrega con los meseros)
+func (g *Game) dejarEnBarra(e *estacion) {
+	plato, ok := g.service.DepositarPlato(e.Receta)
+	if !ok {
+		g.mostrarNotificacion("Barra llena: el plato espera en la estacion")
+		return
+	}
+	e.Estado = estacionLibre
+	g.mostrarNotificacion(fmt.Sprintf("Plato #%d en la barra", plato.ID))
+}
+
+// dibujarCocina dibuja las estaciones con su barra de cocción y al cocinero del jugador
+func (g *Game) dibujarCocina(screen *ebiten.Image) {
+	ahora := g.service.Ahora()
+
+	for i, e := range g.cocina.estaciones {
+		x, y := posicionEstacion(i)
+		fx, fy := float32(x), float32(y)
+
+		borde := color.RGBA{120, 120, 120, 255}
+		if i == g.cocina.actual {
+			borde = color.RGBA{255, 255, 0, 255}
+		}
+		vector.DrawFilledRect(screen, fx, fy, anchoEstacion, altoEstacion, color.RGBA{60, 40, 30, 230}, false)
+		vector.StrokeRect(screen, fx, fy, anchoEstacion, altoEstacion, 2, borde, false)
+
+		switch e.Estado {
+		case estacionLibre:
+			ebitenutil.DebugPrintAt(screen, "Libre", int(x)+8, int(y)+8)
+		case estacionEsperando:
+			ebitenutil.DebugPrintAt(screen, "Listo", int(x)+8, int(y)+8)
+			ebitenutil.DebugPrintAt(screen, "Barra llena", int(x)+8, int(y)+26)
+		case estacionCocinando:
+			ebitenutil.DebugPrintAt(screen, "Cocinando", int(x)+8, int(y)+8)
+			if e.Receta != "" {
+				ebitenutil.DebugPrintAt(screen, e.Receta, int(x)+8, int(y)+24)
+			}
+			g.dibujarProgresoCoccion(screen, fx+8, fy+44, e.progreso(ahora))
+		}
+	}
+
+	// El cocinero se dibuja debajo de la estación elegida
+	x, _ := posicionEstacion(g.cocina.actual)
+	g.renderer.DibujarCocinero(screen, float32(x), float32(estacionY+altoEstacion+5), nil)
+}
+
+// dibujarEstadoCocina escribe en el panel izquierdo el puntaje del cocinero y retorna la nueva altura
+func (g *Game) dibujarEstadoCocina(screen *ebiten.Image, x, y int) int {
+	ebitenutil.DebugPrintAt(screen, "===========================", x, y)
+	y += 20
+	ebitenutil.DebugPrintAt(screen, "COCINA DEL JUGADOR", x, y)
+	y += 20
+	ebitenutil.DebugPrintAt(screen, "===========================", x, y)
+	y += 20
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Emplatados: %d (perfectos: %d)", g.cocina.Emplatados, g.cocina.Perfectos), x, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Quemados: %d", g.cocina.Quemados), x, y)
+	y += 30
+	return y
+}
+
+// dibujarProgresoCoccion dibuja la barra del minijuego: hay que emplatar dentro de la zona verde
+func (g *Game) dibujarProgresoCoccion(screen *ebiten.Image, x, y float32, progreso float64) {
+	ancho := float32(anchoEstacion - 16)
+	escala := ancho / float32(1+ventanaPunto) // Toda la barra es cocción + ventana
+
+	vector.DrawFilledRect(screen, x, y, ancho, 12, color.RGBA{40, 40, 40, 255}, false)
+	vector.DrawFilledRect(screen, x+escala, y, escala*ventanaPunto, 12, color.RGBA{0, 160, 0, 255}, false)
+	vector.DrawFilledRect(screen, x+escala, y, escala*ventanaPerfecta, 12, color.RGBA{120, 255, 120, 255}, false)
+
+	aguja := x + escala*float32(min(progreso, 1+ventanaPunto))
+	vector.StrokeLine(screen, aguja, y-3, aguja, y+15, 2, color.White, false)
+}
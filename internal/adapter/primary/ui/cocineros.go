@@ -0,0 +1,34 @@
+package ui
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Cocineros automáticos en la cocina (arriba a la izquierda, a la izquierda de la barra)
+const (
+	cocineroX          = 50.0
+	cocineroY          = 50.0
+	separacionCocinero = 150.0
+	altoFilaCocinero   = 175.0
+	cocinerosPorFila   = 4
+)
+
+// posicionCocinero retorna dónde se dibuja el i-ésimo cocinero
+func posicionCocinero(i int) (x, y float64) {
+	return cocineroX + float64(i%cocinerosPorFila)*separacionCocinero, cocineroY + float64(i/cocinerosPorFila)*altoFilaCocinero
+}
+
+// dibujarCocineros dibuja un cocinero por goroutine productora con su estado
+// Con pipeline o escenario (o si el jugador es el único cocinero) queda el chef de siempre
+// con el globo de los que esperan lugar en la barra
+func (g *Game) dibujarCocineros(screen *ebiten.Image) {
+	cocineros := g.service.GetCocineros()
+	if len(cocineros) == 0 {
+		g.renderer.DibujarCocinero(screen, cocineroX, cocineroY, g.service.GetBloqueosBarra())
+		return
+	}
+	for i, cocinero := range cocineros {
+		x, y := posicionCocinero(i)
+		g.renderer.DibujarCocineroIndividual(screen, float32(x), float32(y), cocinero)
+	}
+}
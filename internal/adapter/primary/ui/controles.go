@@ -0,0 +1,260 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// infoAccion describe una acción para el perfil (clave JSON) y para la ayuda en pantalla
+type infoAccion struct {
+	Clave       string
+	Descripcion string
+}
+
+// accionesConfigurables son las acciones que se pueden reasignar, en el orden en que se muestran
+var accionesConfigurables = []InputAction{
+	ActionArriba,
+	ActionAbajo,
+	ActionIzquierda,
+	ActionDerecha,
+	ActionRecoger,
+	ActionEntregar,
+	ActionPausar,
+	ActionReset,
+	ActionSalir,
+	ActionAgregarCliente,
+	ActionRemoverCliente,
+	ActionArribaJ2,
+	ActionAbajoJ2,
+	ActionIzquierdaJ2,
+	ActionDerechaJ2,
+	ActionRecogerJ2,
+	ActionEntregarJ2,
+}
+
+// accionesJugador2 son las acciones que se agregaron con el co-op
+var accionesJugador2 = []InputAction{
+	ActionArribaJ2, ActionAbajoJ2, ActionIzquierdaJ2, ActionDerechaJ2, ActionRecogerJ2, ActionEntregarJ2,
+}
+
+var infoAcciones = map[InputAction]infoAccion{
+	ActionArriba:         {"arriba", "Mover arriba"},
+	ActionAbajo:          {"abajo", "Mover abajo"},
+	ActionIzquierda:      {"izquierda", "Mover izquierda"},
+	ActionDerecha:        {"derecha", "Mover derecha"},
+	ActionRecoger:        {"recoger", "Recoger plato"},
+	ActionEntregar:       {"entregar", "Entregar plato"},
+	ActionPausar:         {"pausar", "Pausar/Reanudar"},
+	ActionReset:          {"reiniciar", "Reiniciar restaurante"},
+	ActionSalir:          {"salir", "Cerrar restaurante"},
+	ActionAgregarCliente: {"agregar_cliente", "Agregar cliente"},
+	ActionRemoverCliente: {"remover_cliente", "Remover cliente"},
+	ActionArribaJ2:       {"arriba_j2", "J2 mover arriba"},
+	ActionAbajoJ2:        {"abajo_j2", "J2 mover abajo"},
+	ActionIzquierdaJ2:    {"izquierda_j2", "J2 mover izquierda"},
+	ActionDerechaJ2:      {"derecha_j2", "J2 mover derecha"},
+	ActionRecogerJ2:      {"recoger_j2", "J2 recoger plato"},
+	ActionEntregarJ2:     {"entregar_j2", "J2 entregar plato"},
+}
+
+// teclasReservadas son las teclas fijas de menús, paneles y cuadros de confirmación
+// No se pueden asignar a una acción: la misma tecla haría dos cosas a la vez
+var teclasReservadas = map[ebiten.Key]string{
+	ebiten.KeyF1:    "Ver controles",
+	ebiten.KeyF3:    "Modo cooperativo",
+	ebiten.KeyF4:    "Cambiar rol",
+	ebiten.KeyF6:    "Cambiar escenario",
+	ebiten.KeyF7:    "Corregir escenario",
+	ebiten.KeyF8:    "Linea de tiempo",
+	ebiten.KeyEnter: "Confirmar",
+	ebiten.KeyM:     "Volver al menu",
+	ebiten.KeyN:     "Responder que no",
+}
+
+// Controles es el mapeo de acciones a teclas
+type Controles struct {
+	teclas map[InputAction][]ebiten.Key
+}
+
+// ControlesPorDefecto retorna el mapeo original del juego, sin teclas repetidas:
+// ESPACIO entrega y P pausa; agregar/remover clientes quedan sin asignar
+// El jugador 2 usa las flechas (en modo de un jugador también mueven al jugador 1)
+func ControlesPorDefecto() *Controles {
+	return &Controles{teclas: map[InputAction][]ebiten.Key{
+		ActionArriba:      {ebiten.KeyW},
+		ActionAbajo:       {ebiten.KeyS},
+		ActionIzquierda:   {ebiten.KeyA},
+		ActionDerecha:     {ebiten.KeyD},
+		ActionRecoger:     {ebiten.KeyE},
+		ActionEntregar:    {ebiten.KeySpace},
+		ActionPausar:      {ebiten.KeyP},
+		ActionReset:       {ebiten.KeyF5},
+		ActionSalir:       {ebiten.KeyQ, ebiten.KeyEscape},
+		ActionArribaJ2:    {ebiten.KeyArrowUp},
+		ActionAbajoJ2:     {ebiten.KeyArrowDown},
+		ActionIzquierdaJ2: {ebiten.KeyArrowLeft},
+		ActionDerechaJ2:   {ebiten.KeyArrowRight},
+		ActionRecogerJ2:   {ebiten.KeyPeriod},
+		ActionEntregarJ2:  {ebiten.KeySlash},
+	}}
+}
+
+// CargarControles arma los controles desde un perfil guardado (acción -> nombres de tecla)
+// Las acciones que no aparecen en el perfil conservan sus teclas por defecto
+// Los perfiles guardados antes del co-op se migran (ver migrarJugador2); se retorna
+// cuántas teclas cambiaron de acción
+func CargarControles(perfil map[string][]string) (*Controles, int, error) {
+	c := ControlesPorDefecto()
+
+	for clave, nombres := range perfil {
+		accion, ok := accionPorClave(clave)
+		if !ok {
+			return nil, 0, fmt.Errorf("acción desconocida en el perfil de controles: %q", clave)
+		}
+
+		teclas := make([]ebiten.Key, 0, len(nombres))
+		for _, nombre := range nombres {
+			var tecla ebiten.Key
+			if err := tecla.UnmarshalText([]byte(nombre)); err != nil {
+				return nil, 0, fmt.Errorf("tecla inválida para %q: %w", clave, err)
+			}
+			teclas = append(teclas, tecla)
+		}
+		c.teclas[accion] = teclas
+	}
+	migradas := c.migrarJugador2(perfil)
+
+	if conflictos := c.Conflictos(); len(conflictos) > 0 {
+		return nil, 0, fmt.Errorf("perfil de controles con conflictos: %s", strings.Join(conflictos, "; "))
+	}
+	return c, migradas, nil
+}
+
+// migrarJugador2 adapta un perfil guardado antes del co-op, que no tiene las acciones del
+// jugador 2 y suele asignar las flechas al jugador 1: cada tecla por defecto del jugador 2
+// que el perfil usaba en una acción del jugador 1 pasa al jugador 2 (en modo de un jugador
+// las flechas siguen moviendo al jugador 1)
+// Si el perfil ya la usa en otra acción del jugador 2, la tecla por defecto se descarta
+func (c *Controles) migrarJugador2(perfil map[string][]string) int {
+	migradas := 0
+	for _, accion := range accionesJugador2 {
+		if _, guardada := perfil[infoAcciones[accion].Clave]; guardada {
+			continue
+		}
+		for _, tecla := range c.teclas[accion] {
+			otra := c.AccionDe(tecla)
+			if otra == ActionNone || otra == accion {
+				continue
+			}
+			if slices.Contains(accionesJugador2, otra) {
+				c.teclas[accion] = sinTecla(c.teclas[accion], tecla)
+				continue
+			}
+			c.teclas[otra] = sinTecla(c.teclas[otra], tecla)
+			migradas++
+		}
+	}
+	return migradas
+}
+
+// sinTecla retorna las teclas sin la indicada
+func sinTecla(teclas []ebiten.Key, tecla ebiten.Key) []ebiten.Key {
+	resto := make([]ebiten.Key, 0, len(teclas))
+	for _, t := range teclas {
+		if t != tecla {
+			resto = append(resto, t)
+		}
+	}
+	return resto
+}
+
+func accionPorClave(clave string) (InputAction, bool) {
+	for accion, info := range infoAcciones {
+		if info.Clave == clave {
+			return accion, true
+		}
+	}
+	return ActionNone, false
+}
+
+// Perfil retorna el mapeo en el formato que se persiste
+func (c *Controles) Perfil() map[string][]string {
+	perfil := make(map[string][]string, len(accionesConfigurables))
+	for _, accion := range accionesConfigurables {
+		nombres := make([]string, 0, len(c.teclas[accion]))
+		for _, tecla := range c.teclas[accion] {
+			nombres = append(nombres, tecla.String())
+		}
+		perfil[infoAcciones[accion].Clave] = nombres
+	}
+	return perfil
+}
+
+// Teclas retorna las teclas asignadas a una acción
+func (c *Controles) Teclas(accion InputAction) []ebiten.Key {
+	return c.teclas[accion]
+}
+
+// AccionDe retorna la acción que usa una tecla (ActionNone si está libre)
+func (c *Controles) AccionDe(tecla ebiten.Key) InputAction {
+	for _, accion := range accionesConfigurables {
+		for _, t := range c.teclas[accion] {
+			if t == tecla {
+				return accion
+			}
+		}
+	}
+	return ActionNone
+}
+
+// Asignar reemplaza las teclas de una acción por una sola tecla
+// No se asigna si la tecla está reservada o ya la usa otra acción
+func (c *Controles) Asignar(accion InputAction, tecla ebiten.Key) error {
+	if uso, reservada := teclasReservadas[tecla]; reservada {
+		return fmt.Errorf("[%s] esta reservada para %s", tecla, uso)
+	}
+	if otra := c.AccionDe(tecla); otra != ActionNone && otra != accion {
+		return fmt.Errorf("[%s] ya se usa para %s", tecla, infoAcciones[otra].Descripcion)
+	}
+	c.teclas[accion] = []ebiten.Key{tecla}
+	return nil
+}
+
+// Conflictos retorna una descripción por cada tecla asignada a más de una acción
+// o a una acción y a un uso reservado
+func (c *Controles) Conflictos() []string {
+	usadaPor := make(map[ebiten.Key]InputAction)
+	var conflictos []string
+	for _, accion := range accionesConfigurables {
+		for _, tecla := range c.teclas[accion] {
+			if uso, reservada := teclasReservadas[tecla]; reservada {
+				conflictos = append(conflictos, fmt.Sprintf("[%s] en %q y reservada para %q",
+					tecla, infoAcciones[accion].Descripcion, uso))
+				continue
+			}
+			if otra, usada := usadaPor[tecla]; usada && otra != accion {
+				conflictos = append(conflictos, fmt.Sprintf("[%s] en %q y %q",
+					tecla, infoAcciones[otra].Descripcion, infoAcciones[accion].Descripcion))
+				continue
+			}
+			usadaPor[tecla] = accion
+		}
+	}
+	return conflictos
+}
+
+// Etiqueta retorna las teclas de una acción para mostrar en pantalla (ej. "W/ArrowUp")
+func (c *Controles) Etiqueta(accion InputAction) string {
+	teclas := c.teclas[accion]
+	if len(teclas) == 0 {
+		return "-"
+	}
+	nombres := make([]string, 0, len(teclas))
+	for _, tecla := range teclas {
+		nombres = append(nombres, tecla.String())
+	}
+	return strings.Join(nombres, "/")
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Panel de la despensa (arriba a la derecha, junto a las estaciones del jugador)
+const (
+	despensaX     = 1620.0
+	despensaY     = 40.0
+	anchoDespensa = 280.0
+	anchoStock    = 140.0
+)
+
+// dibujarDespensa dibuja el stock de cada ingrediente y cuántos cocineros esperan al proveedor
+func (g *Game) dibujarDespensa(screen *ebiten.Image) {
+	ingredientes, esperando := g.service.GetDespensa()
+	if ingredientes == nil {
+		return
+	}
+
+	alto := float32(60 + 20*len(ingredientes))
+	vector.DrawFilledRect(screen, despensaX, despensaY, anchoDespensa, alto, color.RGBA{20, 20, 30, 200}, false)
+	vector.StrokeRect(screen, despensaX, despensaY, anchoDespensa, alto, 2, color.RGBA{120, 200, 120, 255}, false)
+
+	x, y := int(despensaX)+10, int(despensaY)+8
+	ebitenutil.DebugPrintAt(screen, "DESPENSA", x, y)
+	y += 22
+
+	for _, ingrediente := range ingredientes {
+		ebitenutil.DebugPrintAt(screen, ingrediente.Nombre, x, y)
+
+		// Barra de stock: verde con lugar, naranja con poco, roja vacía
+		llenado := 0.0
+		if ingrediente.Capacidad > 0 {
+			llenado = float64(ingrediente.Stock) / float64(ingrediente.Capacidad)
+		}
+		col := color.RGBA{0, 200, 0, 255}
+		if ingrediente.Stock == 0 {
+			col = color.RGBA{220, 40, 40, 255}
+		} else if llenado < 0.3 {
+			col = color.RGBA{255, 140, 0, 255}
+		}
+		barraX := float32(x + 90)
+		vector.DrawFilledRect(screen, barraX, float32(y+2), anchoStock, 10, color.RGBA{60, 60, 70, 255}, false)
+		vector.DrawFilledRect(screen, barraX, float32(y+2), anchoStock*float32(llenado), 10, col, false)
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%d/%d", ingrediente.Stock, ingrediente.Capacidad), x+235, y)
+		y += 20
+	}
+
+	if esperando > 0 {
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%d cocinero(s) sin ingredientes", esperando), x, y+4)
+	}
+}
@@ -12,22 +12,48 @@ import (
 )
 
 type Game struct {
-	service       *service.RestaurantService
-	mesero        *model.Mesero
-	inputHandler  *InputHandler
-	renderer      *Renderer
-	width, height int
-
-	// Control de teclas para evitar repetición
-	ePressedLastFrame     bool
-	spacePressedLastFrame bool
+	service     *service.RestaurantService
+	jugadores   []*jugador // Meseros controlados por personas (dos en co-op)
+	cooperativo bool
+	rol         rolJugador      // Mesero (consume) o cocinero (produce)
+	cocina      *cocinaJugador  // Estaciones del jugador en el rol de cocinero
+	meserosIA   []*meseroIA     // Meseros automáticos en el rol de cocinero
+	escenario   model.Escenario // Escenario didáctico de concurrencia (interbloqueo, inanición)
+
+	// Línea de tiempo de estados de cocineros y meseros (F8)
+	mostrarLineaTiempo bool
+	inputHandler       *InputHandler
+	renderer           *Renderer
+	width, height      int
 
 	// Notificación temporal
 	notificacion       string
 	notificacionFrames int
+
+	// Turno de servicio (modo de juego con tiempo límite)
+	turno   *model.Turno
+	resumen *model.ResumenTurno // != nil cuando el turno terminó
+
+	// Niveles de dificultad
+	niveles       []model.Nivel
+	nivelActual   int
+	enMenu        bool // Selección de nivel
+	seleccionMenu int
+
+	// Confirmación de salida (Q/ESC)
+	confirmandoSalida bool
+	pausaDeSalida     bool // La confirmación pausó el juego (al responder se reanuda)
+
+	// Reasignación de teclas (desde el menú de niveles)
+	enControles      bool
+	seleccionControl int
+	esperandoTecla   bool
+	avisoControles   string // Resultado del último cambio o conflicto
+	avisoPerfil      string // Problema o migración del perfil guardado (se muestra en el menú)
+	almacenControles AlmacenControles
 }
 
-func NewGame(service *service.RestaurantService, width, height int) (*Game, error) {
+func NewGame(service *service.RestaurantService, niveles []model.Nivel, width, height int) (*Game, error) {
 	renderer, err := NewRenderer()
 	if err != nil {
 		return nil, err
@@ -35,13 +61,15 @@ func NewGame(service *service.RestaurantService, width, height int) (*Game, erro
 
 	game := &Game{
 		service:      service,
-		mesero:       model.NewMesero(400, 200, 200), // Posición inicial
 		inputHandler: NewInputHandler(),
 		renderer:     renderer,
+		niveles:      niveles,
+		enMenu:       true,
 		width:        width,
 		height:       height,
 	}
 
+	game.jugadores = game.crearJugadores()
 	game.setupCallbacks()
 	return game, nil
 }
@@ -53,85 +81,169 @@ func (g *Game) setupCallbacks() {
 		nil,                    // Ya no agregamos clientes manualmente
 		nil,                    // Ya no removemos clientes manualmente
 		g.handleClose,          // Cerrar - método helper
-		nil,
+		g.handleReset,          // Reiniciar (F5) - método helper
 	)
 }
 
-// handleClose maneja el cierre del juego
+// handleReset reinicia el restaurante (goroutines, mesas y métricas) y el turno actual
+func (g *Game) handleReset() {
+	g.reiniciarTurno()
+	g.mostrarNotificacion("Restaurante reiniciado")
+}
+
+// handleClose pide confirmación antes de cerrar el restaurante
+// Mientras se pregunta el juego queda en pausa, como con la tecla de pausa
 func (g *Game) handleClose() {
-	// Aquí se puede agregar lógica de cierre si es necesaria
+	if cerrando, _ := g.service.GetCierre(); !cerrando {
+		g.confirmandoSalida = true
+		g.pausaDeSalida = g.service.Pausar()
+	}
+}
+
+// responderSalida cierra la confirmación y reanuda el juego si la confirmación lo pausó
+func (g *Game) responderSalida() {
+	g.confirmandoSalida = false
+	if g.pausaDeSalida {
+		g.pausaDeSalida = false
+		g.service.Reanudar()
+	}
+}
+
+// confirmarCierre inicia la "hora de cerrar": se sirven las mesas restantes antes de salir
+func (g *Game) confirmarCierre() {
+	g.service.IniciarCierre(service.PlazoCierre)
+	g.mostrarNotificacion("Hora de cerrar: sirve las mesas que quedan")
 }
 
 func (g *Game) Update() error {
-	// Procesar input
-	g.inputHandler.Update()
+	// Cierre del restaurante (Q/ESC o señal del sistema): salir al terminar de servir
+	// (en pausa CierreTerminado es false: el plazo queda congelado hasta reanudar)
+	if cerrando, _ := g.service.GetCierre(); cerrando {
+		if g.enMenu || g.resumen != nil || g.service.CierreTerminado() {
+			return ErrCierreSolicitado
+		}
+	}
 
-	// Movimiento del mesero (WASD o flechas)
-	dx, dy := 0.0, 0.0
-	if ebiten.IsKeyPressed(ebiten.KeyW) || ebiten.IsKeyPressed(ebiten.KeyArrowUp) {
-		dy = -1
+	// Conexión y desconexión de controles en cualquier pantalla
+	if conectado, desconectado := g.inputHandler.ActualizarGamepad(); conectado {
+		g.mostrarNotificacion("Control conectado")
+	} else if desconectado {
+		g.mostrarNotificacion("Control desconectado")
 	}
-	if ebiten.IsKeyPressed(ebiten.KeyS) || ebiten.IsKeyPressed(ebiten.KeyArrowDown) {
-		dy = 1
+
+	// Confirmación de salida pendiente
+	if g.confirmandoSalida {
+		g.actualizarConfirmacion()
+		return nil
 	}
-	if ebiten.IsKeyPressed(ebiten.KeyA) || ebiten.IsKeyPressed(ebiten.KeyArrowLeft) {
-		dx = -1
+
+	// Pantalla de controles (se abre desde el menú)
+	if g.enControles {
+		g.actualizarControles()
+		return nil
 	}
-	if ebiten.IsKeyPressed(ebiten.KeyD) || ebiten.IsKeyPressed(ebiten.KeyArrowRight) {
-		dx = 1
+
+	// Menú de selección de nivel
+	if g.enMenu {
+		g.actualizarMenu()
+		return nil
 	}
 
-	// Normalizar movimiento diagonal
-	if dx != 0 && dy != 0 {
-		factor := 0.707 // sqrt(2)/2
-		dx *= factor
-		dy *= factor
+	// Pantalla de resumen: solo se espera el reinicio
+	if g.resumen != nil {
+		g.actualizarResumen()
+		return nil
 	}
 
-	g.mesero.Mover(dx, dy, 1.0/60.0)
+	// Procesar input
+	g.inputHandler.Update()
+
+	// La línea de tiempo se puede abrir también en pausa (queda congelada)
+	g.actualizarLineaTiempo()
 
-	// Recoger plato de la barra con E
-	if g.inputHandler.IsKeyJustPressed(ebiten.KeyE) && !g.mesero.TienePlato {
-		if g.meseroEnBarra() {
-			if plato, ok := g.service.IntentarRecogerPlato(); ok {
-				g.mesero.RecogerPlato(*plato)
-				g.mostrarNotificacion(fmt.Sprintf("Plato #%d recogido", plato.ID))
-			} else {
-				g.mostrarNotificacion("No hay platos en la barra")
-			}
-		} else {
-			g.mostrarNotificacion("Acercate a la barra (zona central superior)")
-		}
+	// En pausa se congela todo: el mesero no se mueve ni corre el reloj del turno
+	if g.service.EstaPausado() {
+		return nil
 	}
 
-	// Entregar plato con ESPACIO
-	if g.inputHandler.IsKeyJustPressed(ebiten.KeySpace) && g.mesero.TienePlato {
-		if g.service.EntregarPlatoAMesa(g.mesero.PosX, g.mesero.PosY, 100) {
-			delivered := g.mesero.EntregarPlato()
-			if delivered != nil {
-				g.mostrarNotificacion(fmt.Sprintf("Plato #%d entregado a la mesa", delivered.ID))
-			} else {
-				g.mostrarNotificacion("Plato entregado a la mesa")
-			}
-		} else {
-			g.mostrarNotificacion("Acercate a una mesa con clientes")
+	// Corrección del escenario de concurrencia (F7)
+	g.actualizarEscenario()
+
+	if g.rol == rolCocinero {
+		// El jugador produce en las estaciones y los meseros automáticos consumen
+		g.actualizarCocina()
+		g.actualizarMeserosIA(1.0 / 60.0)
+	} else {
+		// Click del mouse: destino o interacción (barra/mesa) del jugador 1
+		g.procesarClick()
+
+		// Cada jugador mueve su mesero y usa la barra compartida
+		for _, j := range g.jugadores {
+			g.actualizarJugador(j, 1.0/60.0)
 		}
 	}
+	g.reportarMeseros()
 
 	// Decrementar contador de notificación
 	if g.notificacionFrames > 0 {
 		g.notificacionFrames--
 	}
 
+	g.actualizarTurno(1.0 / 60.0)
+
 	return nil
 }
 
-func (g *Game) meseroEnBarra() bool {
+// intentarRecoger consume un plato de la barra si el mesero está cerca y tiene lugar en la bandeja
+func (g *Game) intentarRecoger(j *jugador) {
+	if j.Mesero.BandejaLlena() {
+		g.notificarJugador(j, fmt.Sprintf("Bandeja llena (%d platos)", j.Mesero.CapacidadBandeja))
+		return
+	}
+	if !g.meseroEnBarra(j.Mesero) {
+		g.notificarJugador(j, "Acercate a la barra (zona central superior)")
+		return
+	}
+
+	if plato, ok := g.service.IntentarRecogerPlato(); ok {
+		j.Mesero.RecogerPlato(*plato)
+		j.Recogidos++
+		g.notificarJugador(j, fmt.Sprintf("Plato #%d recogido (%d/%d en bandeja)",
+			plato.ID, len(j.Mesero.Bandeja), j.Mesero.CapacidadBandeja))
+	} else {
+		g.notificarJugador(j, "No hay platos en la barra")
+	}
+}
+
+// intentarEntregar entrega el plato más antiguo de la bandeja a una mesa cercana con clientes
+func (g *Game) intentarEntregar(j *jugador) {
+	plato, ok := j.Mesero.ProximoPlato()
+	if !ok {
+		return
+	}
+
+	if entrega, ok := g.service.EntregarPlatoAMesa(plato, j.Mesero.PosX, j.Mesero.PosY, 100); ok {
+		j.Mesero.EntregarPlato()
+		j.Entregas++
+		j.Dinero += entrega.Total()
+		j.Propinas += entrega.Propina
+		g.notificarJugador(j, fmt.Sprintf("Plato #%d entregado: %.0f%% satisfechos, propina $%.2f",
+			entrega.PlatoID, entrega.Satisfaccion*100, entrega.Propina))
+	} else {
+		g.notificarJugador(j, "Acercate a una mesa con clientes")
+	}
+}
+
+// posicionBarra retorna la esquina superior izquierda de la barra (coincidente con Renderer)
+func (g *Game) posicionBarra() (x, y float64) {
+	return float64(g.width/2 - 200), 80
+}
+
+func (g *Game) meseroEnBarra(mesero *model.Mesero) bool {
 	// Verificar si el mesero está cerca de la barra (centro superior, coincidente con Renderer)
-	barraX := float64(g.width/2 - 200)
-	barraY := 80.0
-	dx := g.mesero.PosX - barraX
-	dy := g.mesero.PosY - barraY
+	barraX, barraY := g.posicionBarra()
+	dx := mesero.PosX - barraX
+	dy := mesero.PosY - barraY
 	return dx*dx+dy*dy < 150*150 // Radio más grande para facilitar la interacción
 }
 
@@ -144,13 +256,31 @@ func (g *Game) Draw(screen *ebiten.Image) {
 	// Dibujar piso repetid
 	g.renderer.DibujarPiso(screen, g.width, g.height)
 
-	// Dibujar cocinero en la cocina (arriba a la izquierda)
-	g.renderer.DibujarCocinero(screen, 50, 50)
+	if g.enControles {
+		g.dibujarControles(screen)
+		g.dibujarSuperpuestos(screen)
+		return
+	}
+	if g.enMenu {
+		g.dibujarMenu(screen)
+		g.dibujarSuperpuestos(screen)
+		return
+	}
+
+	// Dibujar los cocineros en la cocina (arriba a la izquierda) y, en el rol de cocinero, las estaciones del jugador
+	g.dibujarCocineros(screen)
+	if g.rol == rolCocinero {
+		g.dibujarCocina(screen)
+	}
+	g.dibujarPipeline(screen)
+	g.dibujarDespensa(screen)
+	g.dibujarEquipos(screen)
+	g.dibujarEscenario(screen)
 
 	// Dibujar barra
-	estadoBarra := g.service.GetEstadoBarra()
 	capacidadBarra := g.service.GetCapacidadBarra()
-	g.renderer.DibujarBarra(screen, float32(g.width/2-200), 80, estadoBarra, capacidadBarra)
+	barraX, barraY := g.posicionBarra()
+	g.renderer.DibujarBarra(screen, float32(barraX), float32(barraY), g.service.GetBarraSnapshot(), capacidadBarra)
 
 	// Dibujar mesas con clientes (zona inferior)
 	mesas := g.service.GetMesas()
@@ -158,15 +288,47 @@ func (g *Game) Draw(screen *ebiten.Image) {
 		g.renderer.DibujarMesa(screen, mesa)
 	}
 
-	// Dibujar mesero (controlado por el jugador)
-	g.renderer.DibujarMesero(screen, g.mesero)
+	// Destino marcado con el mouse y meseros de cada jugador
+	for _, j := range g.jugadores {
+		if j.objetivo != nil {
+			g.renderer.DibujarObjetivo(screen, float32(j.objetivo.X), float32(j.objetivo.Y), j.objetivo.Accion != objetivoCaminar)
+		}
+	}
+	for _, j := range g.jugadores {
+		etiqueta := ""
+		if g.cooperativo {
+			etiqueta = fmt.Sprintf("J%d", j.Numero)
+		}
+		g.renderer.DibujarMesero(screen, j.Mesero, j.Tinte, etiqueta)
+	}
+	g.dibujarMeserosIA(screen)
 
 	// Dibujar UI e información
 	g.dibujarUI(screen)
+	g.dibujarLineaTiempo(screen)
+
+	// Resumen de fin de turno encima de todo
+	if g.resumen != nil {
+		g.dibujarResumen(screen)
+	}
+	g.dibujarSuperpuestos(screen)
+}
+
+// dibujarSuperpuestos dibuja los cuadros que van encima de cualquier pantalla
+func (g *Game) dibujarSuperpuestos(screen *ebiten.Image) {
+	// La confirmación de salida también pausa: su cuadro reemplaza al de la pausa
+	if g.confirmandoSalida {
+		g.dibujarConfirmacion(screen)
+	} else if !g.enMenu && g.resumen == nil && g.service.EstaPausado() {
+		g.dibujarPausa(screen)
+	}
 }
 
 func (g *Game) dibujarUI(screen *ebiten.Image) {
-	totales, servidos, perdidos := g.service.GetMetricas()
+	_, _, perdidos := g.service.GetMetricas()
+	contabilidad := g.service.GetContabilidad()
+	dinero, propinas, satisfaccion := g.service.GetEconomia()
+	llegados, sinMesa := g.service.GetLlegadas()
 	estadoBarra := g.service.GetEstadoBarra()
 	capacidadBarra := g.service.GetCapacidadBarra()
 
@@ -180,6 +342,21 @@ func (g *Game) dibujarUI(screen *ebiten.Image) {
 	ebitenutil.DebugPrintAt(screen, "Patron Productor-Consumidor", panelX, y)
 	y += 30
 
+	// Turno en curso
+	ebitenutil.DebugPrintAt(screen, "===========================", panelX, y)
+	y += 20
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("NIVEL %d: %s", g.niveles[g.nivelActual].Numero, g.niveles[g.nivelActual].Nombre), panelX, y)
+	y += 20
+	ebitenutil.DebugPrintAt(screen, "===========================", panelX, y)
+	y += 20
+	restante := g.turno.Restante()
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Tiempo: %d:%02d", int(restante.Minutes()), int(restante.Seconds())%60), panelX, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Meta: $%.2f", g.turno.MetaDinero), panelX, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Perdidos: %d/%d", perdidos, g.turno.MaxPerdidos), panelX, y)
+	y += 30
+
 	// Métricas del sistema
 	ebitenutil.DebugPrintAt(screen, "===========================", panelX, y)
 	y += 20
@@ -189,13 +366,54 @@ func (g *Game) dibujarUI(screen *ebiten.Image) {
 	y += 20
 	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Buffer: %d/%d", estadoBarra, capacidadBarra), panelX, y)
 	y += 18
-	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Producidos: %d", totales), panelX, y)
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Producidos: %d (en barra: %d)", contabilidad.Producidos, contabilidad.EnBarra), panelX, y)
 	y += 18
-	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Servidos: %d", servidos), panelX, y)
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Recogidos: %d (en mano: %d)", contabilidad.Recogidos, contabilidad.EnMano), panelX, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Entregados: %d", contabilidad.Entregados), panelX, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Desperdiciados: %d", contabilidad.Desperdiciados), panelX, y)
 	y += 18
 	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Perdidos: %d", perdidos), panelX, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Grupos llegados: %d (sin mesa: %d)", llegados, sinMesa), panelX, y)
+	y += 18
+
+	// Tiempo que cada cocinero pasó bloqueado con la barra llena (productor esperando al consumidor)
+	if bloqueos := g.service.GetBloqueosBarra(); len(bloqueos) > 0 {
+		ebitenutil.DebugPrintAt(screen, "Bloqueo por barra llena:", panelX, y)
+		y += 18
+		for _, bloqueo := range bloqueos {
+			linea := fmt.Sprintf("  %s: %.1fs", bloqueo.Cocinero, bloqueo.Acumulado.Seconds())
+			if bloqueo.Bloqueado {
+				linea += " (esperando)"
+			}
+			ebitenutil.DebugPrintAt(screen, linea, panelX, y)
+			y += 18
+		}
+	}
+	y += 12
+
+	// Economía del restaurante
+	ebitenutil.DebugPrintAt(screen, "===========================", panelX, y)
+	y += 20
+	ebitenutil.DebugPrintAt(screen, "CAJA", panelX, y)
+	y += 20
+	ebitenutil.DebugPrintAt(screen, "===========================", panelX, y)
+	y += 20
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Dinero: $%.2f", dinero), panelX, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Propinas: $%.2f", propinas), panelX, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Satisfaccion: %.0f%%", satisfaccion*100), panelX, y)
 	y += 30
 
+	// Cierre en curso
+	if cerrando, restante := g.service.GetCierre(); cerrando {
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("CERRANDO: %ds para servir", int(restante.Seconds())), panelX, y)
+		y += 30
+	}
+
 	// Controles
 	ebitenutil.DebugPrintAt(screen, "===========================", panelX, y)
 	y += 20
@@ -203,28 +421,102 @@ func (g *Game) dibujarUI(screen *ebiten.Image) {
 	y += 20
 	ebitenutil.DebugPrintAt(screen, "===========================", panelX, y)
 	y += 20
-	ebitenutil.DebugPrintAt(screen, "[WASD] Mover mesero", panelX, y)
+	// Con control se muestran sus botones; con teclado, las teclas asignadas
+	entrada := g.inputHandler
+	if g.rol == rolCocinero {
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s/%s] Cambiar estacion",
+			entrada.EtiquetaAccion(ActionIzquierda), entrada.EtiquetaAccion(ActionDerecha)), panelX, y)
+		y += 18
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s] Cocinar / Emplatar", entrada.EtiquetaAccion(ActionRecoger)), panelX, y)
+		y += 18
+	} else {
+		if entrada.UsandoGamepad() {
+			ebitenutil.DebugPrintAt(screen, "[STICK/CRUZ] Mover mesero", panelX, y)
+		} else {
+			controles := entrada.Controles()
+			ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s/%s/%s/%s] Mover mesero",
+				controles.Etiqueta(ActionArriba), controles.Etiqueta(ActionIzquierda),
+				controles.Etiqueta(ActionAbajo), controles.Etiqueta(ActionDerecha)), panelX, y)
+		}
+		y += 18
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s] Recoger plato", entrada.EtiquetaAccion(ActionRecoger)), panelX, y)
+		y += 18
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s] Entregar", entrada.EtiquetaAccion(ActionEntregar)), panelX, y)
+		y += 18
+		if !entrada.UsandoGamepad() {
+			ebitenutil.DebugPrintAt(screen, "[CLICK] Ir a barra/mesa/piso", panelX, y)
+			y += 18
+		}
+	}
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s] Pausar", entrada.EtiquetaAccion(ActionPausar)), panelX, y)
 	y += 18
-	ebitenutil.DebugPrintAt(screen, "[E] Recoger plato", panelX, y)
+	if !entrada.UsandoGamepad() {
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s] Reiniciar restaurante", entrada.EtiquetaAccion(ActionReset)), panelX, y)
+		y += 18
+		ebitenutil.DebugPrintAt(screen, "[F8] Linea de tiempo", panelX, y)
+		y += 18
+	}
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s] Cerrar restaurante", entrada.EtiquetaAccion(ActionSalir)), panelX, y)
 	y += 18
-	ebitenutil.DebugPrintAt(screen, "[ESPACIO] Entregar", panelX, y)
-	y += 30
+	if g.cooperativo && g.rol == rolMesero && !entrada.UsandoGamepad() {
+		controles := entrada.Controles()
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("J2: [%s/%s/%s/%s] Mover",
+			controles.Etiqueta(ActionArribaJ2), controles.Etiqueta(ActionIzquierdaJ2),
+			controles.Etiqueta(ActionAbajoJ2), controles.Etiqueta(ActionDerechaJ2)), panelX, y)
+		y += 18
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("J2: [%s] Recoger  [%s] Entregar",
+			controles.Etiqueta(ActionRecogerJ2), controles.Etiqueta(ActionEntregarJ2)), panelX, y)
+		y += 18
+	}
+	y += 12
 
-	// Estado del mesero
+	// En el rol de cocinero se muestra la cocina en lugar de los meseros
+	if g.rol == rolCocinero {
+		y = g.dibujarEstadoCocina(screen, panelX, y)
+	}
+
+	// Estado de cada mesero (en co-op, con el puntaje de cada jugador)
 	ebitenutil.DebugPrintAt(screen, "===========================", panelX, y)
 	y += 20
-	ebitenutil.DebugPrintAt(screen, "ESTADO DEL MESERO", panelX, y)
+	if g.rol == rolCocinero {
+		ebitenutil.DebugPrintAt(screen, "MESEROS AUTOMATICOS", panelX, y)
+	} else if g.cooperativo {
+		ebitenutil.DebugPrintAt(screen, "JUGADORES", panelX, y)
+	} else {
+		ebitenutil.DebugPrintAt(screen, "ESTADO DEL MESERO", panelX, y)
+	}
 	y += 20
 	ebitenutil.DebugPrintAt(screen, "===========================", panelX, y)
 	y += 20
-	if g.mesero.TienePlato {
-		ebitenutil.DebugPrintAt(screen, "Con plato", panelX, y)
+	ahora := g.service.Ahora()
+	for _, j := range g.jugadores {
+		estado := "Libre -> Ve a barra"
+		if j.Mesero.BandejaLlena() {
+			estado = "Bandeja llena -> Busca mesas"
+		} else if j.Mesero.LlevaPlatos() {
+			estado = "Con platos -> Mesa o barra"
+		}
+		if g.cooperativo {
+			estado = fmt.Sprintf("J%d: %s", j.Numero, estado)
+		}
+		ebitenutil.DebugPrintAt(screen, estado, panelX, y)
 		y += 18
-		ebitenutil.DebugPrintAt(screen, "-> Busca mesa", panelX, y)
-	} else {
-		ebitenutil.DebugPrintAt(screen, "Libre", panelX, y)
+		if g.cooperativo {
+			ebitenutil.DebugPrintAt(screen, fmt.Sprintf("    %d entregas  $%.2f", j.Entregas, j.Dinero), panelX, y)
+			y += 18
+		}
+
+		// Platos en la bandeja con su edad (el primero es el próximo a entregar)
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("    Bandeja %d/%d", len(j.Mesero.Bandeja), j.Mesero.CapacidadBandeja), panelX, y)
+		y += 18
+		for _, plato := range j.Mesero.Bandeja {
+			ebitenutil.DebugPrintAt(screen, fmt.Sprintf("    - Plato #%d (%.0fs)", plato.ID, plato.Edad(ahora).Seconds()), panelX, y)
+			y += 18
+		}
+	}
+	for _, m := range g.meserosIA {
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%s: %s", m.etiqueta(), m.descripcion()), panelX, y)
 		y += 18
-		ebitenutil.DebugPrintAt(screen, "-> Ve a barra", panelX, y)
 	}
 
 	// NOTIFICACIÓN CENTRAL (si existe)
@@ -246,6 +538,18 @@ func (g *Game) dibujarUI(screen *ebiten.Image) {
 	}
 }
 
+// dibujarPausa oscurece la pantalla y avisa que el juego está en pausa
+func (g *Game) dibujarPausa(screen *ebiten.Image) {
+	vector.DrawFilledRect(screen, 0, 0, float32(g.width), float32(g.height), color.RGBA{0, 0, 40, 140}, false)
+
+	x := g.width/2 - 110
+	y := g.height/2 - 30
+	vector.DrawFilledRect(screen, float32(x), float32(y), 220, 60, color.RGBA{20, 20, 30, 230}, false)
+	vector.StrokeRect(screen, float32(x), float32(y), 220, 60, 3, color.RGBA{100, 150, 255, 255}, false)
+	ebitenutil.DebugPrintAt(screen, "PAUSA", x+90, y+12)
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s] Reanudar", g.inputHandler.EtiquetaAccion(ActionPausar)), x+55, y+32)
+}
+
 func (g *Game) Layout(w, h int) (int, int) {
 	return g.width, g.height
 }
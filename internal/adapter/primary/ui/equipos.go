@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"restaurant-concurrency/internal/domain/model"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// Equipos compartidos de la cocina (debajo de las zonas del pipeline)
+const (
+	equipoX          = 1200.0
+	equipoY          = 500.0
+	anchoEquipo      = 200.0
+	altoEquipo       = 86.0
+	separacionEquipo = 240.0
+)
+
+// posicionEquipo retorna la esquina superior izquierda del cuadro de un equipo
+func posicionEquipo(i int) (x, y float64) {
+	return equipoX + float64(i%etapasPorFila)*separacionEquipo, equipoY + float64(i/etapasPorFila)*(altoEquipo+20)
+}
+
+// zonasEquipos retorna el área de cada equipo (obstáculos para los meseros)
+func (g *Game) zonasEquipos() []model.Rect {
+	equipos := g.service.GetEquipos()
+	zonas := make([]model.Rect, 0, len(equipos))
+	for i := range equipos {
+		x, y := posicionEquipo(i)
+		zonas = append(zonas, model.Rect{X: x, Y: y, W: anchoEquipo, H: altoEquipo})
+	}
+	return zonas
+}
+
+// dibujarEquipos dibuja cada equipo con quién lo tiene tomado y quién hace cola
+func (g *Game) dibujarEquipos(screen *ebiten.Image) {
+	equipos := g.service.GetEquipos()
+	if len(equipos) == 0 {
+		return
+	}
+
+	ebitenutil.DebugPrintAt(screen, "EQUIPOS (SEMAFOROS)", int(equipoX), int(equipoY-20))
+	for i, equipo := range equipos {
+		x, y := posicionEquipo(i)
+		g.renderer.DibujarEquipo(screen, float32(x), float32(y), anchoEquipo, altoEquipo, equipo)
+	}
+}
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"restaurant-concurrency/internal/domain/model"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Panel del escenario didáctico (debajo de los equipos, que muestran los utensilios)
+const (
+	escenarioX      = 1200.0
+	escenarioY      = 640.0
+	anchoEscenario  = 480.0
+	lineasEscenario = 6
+)
+
+// siguienteEscenario pasa al próximo escenario del menú (F6)
+func (g *Game) siguienteEscenario() {
+	i := slices.Index(model.Escenarios, g.escenario.Tipo)
+	g.escenario.Tipo = model.Escenarios[(i+1)%len(model.Escenarios)]
+}
+
+// describirCorreccion indica si el escenario corre con la corrección aplicada
+func (g *Game) describirCorreccion() string {
+	if g.escenario.Corregido {
+		return "con correccion"
+	}
+	return "sin correccion"
+}
+
+// actualizarEscenario alterna la corrección durante el turno (F7): el restaurante
+// se reinicia para que los cocineros del escenario arranquen con la nueva estrategia
+func (g *Game) actualizarEscenario() {
+	if !g.escenario.Activo() || !g.inputHandler.IsKeyJustPressed(ebiten.KeyF7) {
+		return
+	}
+	g.escenario.Corregido = !g.escenario.Corregido
+	g.service.SetEscenario(g.escenario)
+	g.reiniciarTurno()
+	g.mostrarNotificacion(fmt.Sprintf("%s: %s", g.escenario.Tipo, g.describirCorreccion()))
+}
+
+// zonaEscenario retorna el área del panel del escenario (obstáculo para los meseros)
+func (g *Game) zonaEscenario() []model.Rect {
+	if !g.service.GetEscenario().Activo() {
+		return nil
+	}
+	return []model.Rect{{X: escenarioX, Y: escenarioY, W: anchoEscenario, H: 20 + 18*lineasEscenario}}
+}
+
+// dibujarEscenario dibuja el escenario elegido y lo que encontró el vigilante:
+// quién está atascado y, si hay interbloqueo, el ciclo de esperas en rojo
+func (g *Game) dibujarEscenario(screen *ebiten.Image) {
+	escenario := g.service.GetEscenario()
+	if !escenario.Activo() {
+		return
+	}
+	reporte := g.service.GetReporteBloqueo()
+
+	alto := float32(20 + 18*lineasEscenario)
+	borde := color.RGBA{120, 200, 120, 255}
+	if len(reporte.Atascados) > 0 {
+		borde = color.RGBA{220, 40, 40, 255}
+	}
+	vector.DrawFilledRect(screen, escenarioX, escenarioY, anchoEscenario, alto, color.RGBA{20, 20, 30, 220}, false)
+	vector.StrokeRect(screen, escenarioX, escenarioY, anchoEscenario, alto, 2, borde, false)
+
+	x, y := int(escenarioX)+10, int(escenarioY)+8
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("ESCENARIO: %s (%s)  [F7] Corregir", escenario.Tipo, g.describirCorreccion()), x, y)
+	y += 22
+
+	switch {
+	case reporte.HayInterbloqueo():
+		ebitenutil.DebugPrintAt(screen, "VIGILANTE: INTERBLOQUEO (ciclo de esperas)", x, y)
+		y += 18
+		ebitenutil.DebugPrintAt(screen, reporte.DescribirCiclo(), x, y)
+		y += 18
+	case len(reporte.Atascados) > 0:
+		ebitenutil.DebugPrintAt(screen, "VIGILANTE: INANICION (espera sin ciclo)", x, y)
+		y += 18
+	default:
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("VIGILANTE: nadie espera mas de %v", model.UmbralAtasco), x, y)
+		y += 18
+	}
+
+	for i, atasco := range reporte.Atascados {
+		if i == lineasEscenario-3 {
+			break
+		}
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("- %s espera %s hace %.0fs",
+			atasco.Quien, atasco.Recurso, atasco.Espera.Seconds()), x, y)
+		y += 18
+	}
+}
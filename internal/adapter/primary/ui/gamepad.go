@@ -0,0 +1,222 @@
+package ui
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// zonaMuertaStick es la inclinación mínima del stick para considerar que se mueve
+// (los sticks en reposo rara vez marcan 0 exacto)
+const zonaMuertaStick = 0.2
+
+// botonesGamepad son los botones del layout estándar asignados a cada acción
+// Botones de la cara: A (abajo) recoge, B (derecha) entrega, Y (arriba) pausa
+// Las acciones del jugador 2 usan los mismos botones en el segundo control
+var botonesGamepad = map[InputAction][]ebiten.StandardGamepadButton{
+	ActionArriba:      {ebiten.StandardGamepadButtonLeftTop},
+	ActionAbajo:       {ebiten.StandardGamepadButtonLeftBottom},
+	ActionIzquierda:   {ebiten.StandardGamepadButtonLeftLeft},
+	ActionDerecha:     {ebiten.StandardGamepadButtonLeftRight},
+	ActionRecoger:     {ebiten.StandardGamepadButtonRightBottom},
+	ActionEntregar:    {ebiten.StandardGamepadButtonRightRight},
+	ActionPausar:      {ebiten.StandardGamepadButtonRightTop, ebiten.StandardGamepadButtonCenterRight},
+	ActionSalir:       {ebiten.StandardGamepadButtonCenterLeft},
+	ActionArribaJ2:    {ebiten.StandardGamepadButtonLeftTop},
+	ActionAbajoJ2:     {ebiten.StandardGamepadButtonLeftBottom},
+	ActionIzquierdaJ2: {ebiten.StandardGamepadButtonLeftLeft},
+	ActionDerechaJ2:   {ebiten.StandardGamepadButtonLeftRight},
+	ActionRecogerJ2:   {ebiten.StandardGamepadButtonRightBottom},
+	ActionEntregarJ2:  {ebiten.StandardGamepadButtonRightRight},
+}
+
+// etiquetasGamepad son los nombres de los botones para los paneles de ayuda
+var etiquetasGamepad = map[InputAction]string{
+	ActionRecoger:    "A",
+	ActionEntregar:   "B",
+	ActionPausar:     "Y/START",
+	ActionSalir:      "SELECT",
+	ActionRecogerJ2:  "A",
+	ActionEntregarJ2: "B",
+}
+
+// gamepadDeAccion retorna qué control (0 = primero, 1 = segundo) dispara la acción
+func gamepadDeAccion(accion InputAction) int {
+	switch accion {
+	case ActionArribaJ2, ActionAbajoJ2, ActionIzquierdaJ2, ActionDerechaJ2, ActionRecogerJ2, ActionEntregarJ2:
+		return 1
+	}
+	return 0
+}
+
+// ActualizarGamepad detecta conexiones y desconexiones (llamar en cada frame)
+// Los controles se asignan a los jugadores en el orden en que se conectan;
+// si se desconecta el primero, el segundo pasa a ser del jugador 1
+// Retorna si en este frame se conectó o se desconectó algún control
+func (h *InputHandler) ActualizarGamepad() (conectado, desconectado bool) {
+	vigentes := h.gamepads[:0]
+	for _, id := range h.gamepads {
+		if inpututil.IsGamepadJustDisconnected(id) {
+			desconectado = true
+			continue
+		}
+		vigentes = append(vigentes, id)
+	}
+	h.gamepads = vigentes
+
+	for _, id := range ebiten.AppendGamepadIDs(nil) {
+		// Solo el layout estándar tiene botones y ejes con significado conocido
+		if ebiten.IsStandardGamepadLayoutAvailable(id) && !h.tieneGamepad(id) {
+			h.gamepads = append(h.gamepads, id)
+			conectado = true
+		}
+	}
+
+	if len(h.gamepads) == 0 {
+		h.usandoGamepad = false
+	}
+	h.actualizarFuenteEntrada()
+	return conectado, desconectado
+}
+
+func (h *InputHandler) tieneGamepad(id ebiten.GamepadID) bool {
+	for _, g := range h.gamepads {
+		if g == id {
+			return true
+		}
+	}
+	return false
+}
+
+// gamepad retorna el control del índice pedido si está conectado
+func (h *InputHandler) gamepad(indice int) (ebiten.GamepadID, bool) {
+	if indice >= len(h.gamepads) {
+		return 0, false
+	}
+	return h.gamepads[indice], true
+}
+
+// actualizarFuenteEntrada recuerda si lo último que se usó fue un control o el teclado/mouse
+func (h *InputHandler) actualizarFuenteEntrada() {
+	if len(inpututil.AppendJustPressedKeys(nil)) > 0 || inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		h.usandoGamepad = false
+		return
+	}
+	for i, id := range h.gamepads {
+		dx, dy := h.Stick(i)
+		if dx != 0 || dy != 0 || len(inpututil.AppendJustPressedStandardGamepadButtons(id, nil)) > 0 {
+			h.usandoGamepad = true
+			return
+		}
+	}
+}
+
+// UsandoGamepad indica si la última entrada vino de un control (para mostrar sus botones)
+func (h *InputHandler) UsandoGamepad() bool {
+	return h.usandoGamepad
+}
+
+// Stick retorna la inclinación del stick izquierdo del control indicado, con magnitud entre 0 y 1
+// Por debajo de la zona muerta retorna 0, y el resto se reescala para arrancar suave
+func (h *InputHandler) Stick(indice int) (dx, dy float64) {
+	id, ok := h.gamepad(indice)
+	if !ok {
+		return 0, 0
+	}
+	x := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickHorizontal)
+	y := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickVertical)
+
+	magnitud := math.Hypot(x, y)
+	if magnitud < zonaMuertaStick {
+		return 0, 0
+	}
+	escala := math.Min(1, (magnitud-zonaMuertaStick)/(1-zonaMuertaStick)) / magnitud
+	return x * escala, y * escala
+}
+
+// isGamepadActionPressed verifica si algún botón del control asignado a la acción está presionado
+func (h *InputHandler) isGamepadActionPressed(accion InputAction) bool {
+	id, ok := h.gamepad(gamepadDeAccion(accion))
+	if !ok {
+		return false
+	}
+	for _, boton := range botonesGamepad[accion] {
+		if ebiten.IsStandardGamepadButtonPressed(id, boton) {
+			return true
+		}
+	}
+	return false
+}
+
+// isGamepadActionJustPressed verifica si algún botón del control asignado a la acción acaba de ser presionado
+func (h *InputHandler) isGamepadActionJustPressed(accion InputAction) bool {
+	id, ok := h.gamepad(gamepadDeAccion(accion))
+	if !ok {
+		return false
+	}
+	for _, boton := range botonesGamepad[accion] {
+		if inpututil.IsStandardGamepadButtonJustPressed(id, boton) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsConfirmarJustPressed verifica ENTER o el botón A de cualquier control (menús y resumen)
+func (h *InputHandler) IsConfirmarJustPressed() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyEnter) || h.algunGamepadJustPressed(ebiten.StandardGamepadButtonRightBottom)
+}
+
+// IsCancelarJustPressed verifica ESC o el botón B de cualquier control (cuadros de confirmación)
+func (h *InputHandler) IsCancelarJustPressed() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyEscape) || h.algunGamepadJustPressed(ebiten.StandardGamepadButtonRightRight)
+}
+
+// IsMenuJustPressed verifica M o el botón X de cualquier control (volver al menú desde el resumen)
+func (h *InputHandler) IsMenuJustPressed() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyM) || h.algunGamepadJustPressed(ebiten.StandardGamepadButtonRightLeft)
+}
+
+func (h *InputHandler) algunGamepadJustPressed(boton ebiten.StandardGamepadButton) bool {
+	for _, id := range h.gamepads {
+		if inpututil.IsStandardGamepadButtonJustPressed(id, boton) {
+			return true
+		}
+	}
+	return false
+}
+
+// EtiquetaAccion retorna cómo se muestra la acción en pantalla según la entrada en uso
+func (h *InputHandler) EtiquetaAccion(accion InputAction) string {
+	if h.usandoGamepad {
+		if etiqueta, ok := etiquetasGamepad[accion]; ok {
+			return etiqueta
+		}
+	}
+	return h.controles.Etiqueta(accion)
+}
+
+// EtiquetaConfirmar retorna cómo se muestra la confirmación según la entrada en uso
+func (h *InputHandler) EtiquetaConfirmar() string {
+	if h.usandoGamepad {
+		return "A"
+	}
+	return "ENTER"
+}
+
+// EtiquetaSiNo retorna cómo se responde un cuadro de confirmación según la entrada en uso
+func (h *InputHandler) EtiquetaSiNo() string {
+	if h.usandoGamepad {
+		return "A/B"
+	}
+	return "ENTER/N"
+}
+
+// EtiquetaMenu retorna cómo se vuelve al menú de niveles según la entrada en uso
+func (h *InputHandler) EtiquetaMenu() string {
+	if h.usandoGamepad {
+		return "X"
+	}
+	return "M"
+}
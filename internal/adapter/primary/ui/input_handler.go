@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"fmt"
+
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
@@ -18,7 +20,12 @@ type InputHandler struct {
 	onReset          func()
 
 	// Configuración
-	enabled bool
+	enabled   bool
+	controles *Controles // Teclas asignadas a cada acción
+
+	// Controles (gamepads) en uso: el primero es del jugador 1 y el segundo del jugador 2
+	gamepads      []ebiten.GamepadID
+	usandoGamepad bool // La última entrada vino de un control
 }
 
 // InputAction representa una acción que puede realizar el usuario
@@ -30,15 +37,65 @@ const (
 	ActionRemoverCliente
 	ActionSalir
 	ActionReset
+	ActionArriba
+	ActionAbajo
+	ActionIzquierda
+	ActionDerecha
+	ActionRecoger
+	ActionEntregar
+	// Jugador 2 (co-op local)
+	ActionArribaJ2
+	ActionAbajoJ2
+	ActionIzquierdaJ2
+	ActionDerechaJ2
+	ActionRecogerJ2
+	ActionEntregarJ2
 	ActionNone
 )
 
-// NewInputHandler crea un nuevo manejador de entrada
+// NewInputHandler crea un nuevo manejador de entrada con los controles por defecto
 func NewInputHandler() *InputHandler {
 	return &InputHandler{
 		lastFrameKeys: make(map[ebiten.Key]bool),
 		enabled:       true,
+		controles:     ControlesPorDefecto(),
+	}
+}
+
+// SetControles reemplaza el mapeo de acciones a teclas
+func (h *InputHandler) SetControles(controles *Controles) {
+	h.controles = controles
+}
+
+// Controles retorna el mapeo de acciones a teclas en uso
+func (h *InputHandler) Controles() *Controles {
+	return h.controles
+}
+
+// IsActionPressed verifica si alguna tecla o botón del control de la acción está presionado
+func (h *InputHandler) IsActionPressed(accion InputAction) bool {
+	if h.isGamepadActionPressed(accion) {
+		return true
 	}
+	for _, tecla := range h.controles.Teclas(accion) {
+		if ebiten.IsKeyPressed(tecla) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActionJustPressed verifica si alguna tecla o botón del control de la acción acaba de ser presionado
+func (h *InputHandler) IsActionJustPressed(accion InputAction) bool {
+	if h.isGamepadActionJustPressed(accion) {
+		return true
+	}
+	for _, tecla := range h.controles.Teclas(accion) {
+		if inpututil.IsKeyJustPressed(tecla) {
+			return true
+		}
+	}
+	return false
 }
 
 // SetCallbacks configura las funciones callback para cada acción
@@ -77,58 +134,47 @@ func (h *InputHandler) Update() error {
 		return nil
 	}
 
-	// Detectar teclas presionadas (solo al momento de presionar)
-	if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+	// Detectar acciones (solo al momento de presionar)
+	if h.IsActionJustPressed(ActionPausar) {
 		if h.onPausar != nil {
 			h.onPausar()
 		}
 	}
 
-	if inpututil.IsKeyJustPressed(ebiten.KeyA) {
-		if h.onAgregarCliente != nil {
-			h.onAgregarCliente()
+	// Shift multiplica por 5 las acciones de clientes
+	veces := 1
+	if ebiten.IsKeyPressed(ebiten.KeyShift) {
+		veces = 5
+	}
+
+	if h.IsActionJustPressed(ActionAgregarCliente) {
+		for i := 0; i < veces; i++ {
+			if h.onAgregarCliente != nil {
+				h.onAgregarCliente()
+			}
 		}
 	}
 
-	if inpututil.IsKeyJustPressed(ebiten.KeyR) {
-		if h.onRemoverCliente != nil {
-			h.onRemoverCliente()
+	if h.IsActionJustPressed(ActionRemoverCliente) {
+		for i := 0; i < veces; i++ {
+			if h.onRemoverCliente != nil {
+				h.onRemoverCliente()
+			}
 		}
 	}
 
-	if inpututil.IsKeyJustPressed(ebiten.KeyQ) || inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+	if h.IsActionJustPressed(ActionSalir) {
 		if h.onSalir != nil {
 			h.onSalir()
 		}
 	}
 
-	if inpututil.IsKeyJustPressed(ebiten.KeyF5) {
+	if h.IsActionJustPressed(ActionReset) {
 		if h.onReset != nil {
 			h.onReset()
 		}
 	}
 
-	// Soporte para múltiples clientes a la vez
-	if ebiten.IsKeyPressed(ebiten.KeyShift) {
-		if inpututil.IsKeyJustPressed(ebiten.KeyA) {
-			// Agregar 5 clientes con Shift+A
-			for i := 0; i < 5; i++ {
-				if h.onAgregarCliente != nil {
-					h.onAgregarCliente()
-				}
-			}
-		}
-
-		if inpututil.IsKeyJustPressed(ebiten.KeyR) {
-			// Remover 5 clientes con Shift+R
-			for i := 0; i < 5; i++ {
-				if h.onRemoverCliente != nil {
-					h.onRemoverCliente()
-				}
-			}
-		}
-	}
-
 	return nil
 }
 
@@ -158,37 +204,25 @@ func (h *InputHandler) GetPressedAction() InputAction {
 		return ActionNone
 	}
 
-	if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
-		return ActionPausar
-	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyA) {
-		return ActionAgregarCliente
-	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyR) {
-		return ActionRemoverCliente
-	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyQ) || inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
-		return ActionSalir
-	}
-	if inpututil.IsKeyJustPressed(ebiten.KeyF5) {
-		return ActionReset
+	for _, accion := range accionesConfigurables {
+		if h.IsActionJustPressed(accion) {
+			return accion
+		}
 	}
 
 	return ActionNone
 }
 
-// GetHelpText retorna el texto de ayuda con todos los controles
+// GetHelpText retorna el texto de ayuda con los controles asignados
 func (h *InputHandler) GetHelpText() []string {
-	return []string{
-		"CONTROLES:",
-		"[SPACE]     Pausar/Reanudar producción",
-		"[A]         Agregar 1 cliente",
-		"[Shift+A]   Agregar 5 clientes",
-		"[R]         Remover 1 cliente",
-		"[Shift+R]   Remover 5 clientes",
-		"[F5]        Reiniciar restaurante",
-		"[Q/ESC]     Salir",
+	lineas := []string{"CONTROLES:"}
+	for _, accion := range accionesConfigurables {
+		if len(h.controles.Teclas(accion)) == 0 {
+			continue
+		}
+		lineas = append(lineas, fmt.Sprintf("%-14s %s", "["+h.controles.Etiqueta(accion)+"]", infoAcciones[accion].Descripcion))
 	}
+	return lineas
 }
 
 // MousePosition retorna la posición actual del mouse
@@ -0,0 +1,158 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"restaurant-concurrency/internal/domain/model"
+)
+
+// accionesJugador son las acciones (teclas y control) que mueven a un mesero
+type accionesJugador struct {
+	Arriba, Abajo, Izquierda, Derecha InputAction
+	Recoger, Entregar                 InputAction
+	Gamepad                           int // Índice del control (0 = primero conectado)
+}
+
+var (
+	accionesJ1 = accionesJugador{ActionArriba, ActionAbajo, ActionIzquierda, ActionDerecha, ActionRecoger, ActionEntregar, 0}
+	accionesJ2 = accionesJugador{ActionArribaJ2, ActionAbajoJ2, ActionIzquierdaJ2, ActionDerechaJ2, ActionRecogerJ2, ActionEntregarJ2, 1}
+)
+
+// Color y posición inicial de cada jugador
+var (
+	tintesJugador      = []color.RGBA{{255, 255, 255, 255}, {150, 200, 255, 255}}
+	posicionesJugador  = [][2]float64{{400, 200}, {600, 200}}
+	velocidadJugadores = 200.0
+)
+
+// jugador es un mesero controlado por una persona
+// En co-op local hay dos y ambos consumen de la misma barra
+type jugador struct {
+	Numero   int
+	Mesero   *model.Mesero
+	Acciones []accionesJugador // En modo de un jugador, el jugador 1 responde a ambos juegos de teclas
+	Tinte    color.RGBA
+	objetivo *objetivoMesero // Destino marcado con el mouse (solo jugador 1)
+
+	// Puntaje del turno
+	Recogidos int
+	Entregas  int
+	Dinero    float64
+	Propinas  float64
+}
+
+// crearJugadores arma los jugadores del turno; en co-op los meseros chocan entre sí
+func (g *Game) crearJugadores() []*jugador {
+	mapa := g.construirMapa()
+
+	jugadores := []*jugador{g.nuevoJugador(1, mapa, accionesJ1, accionesJ2)}
+	if g.cooperativo {
+		jugadores[0].Acciones = []accionesJugador{accionesJ1}
+		jugadores = append(jugadores, g.nuevoJugador(2, mapa, accionesJ2))
+	}
+
+	meseros := make([]*model.Mesero, 0, len(jugadores))
+	for _, j := range jugadores {
+		meseros = append(meseros, j.Mesero)
+	}
+	for _, j := range jugadores {
+		j.Mesero.Companeros = meseros
+	}
+	return jugadores
+}
+
+func (g *Game) nuevoJugador(numero int, mapa *model.Mapa, acciones ...accionesJugador) *jugador {
+	pos := posicionesJugador[numero-1]
+	mesero := model.NewMesero(pos[0], pos[1], velocidadJugadores)
+	mesero.Mapa = mapa
+	mesero.CapacidadBandeja = max(1, g.niveles[g.nivelActual].CapacidadBandeja)
+	return &jugador{
+		Numero:   numero,
+		Mesero:   mesero,
+		Acciones: acciones,
+		Tinte:    tintesJugador[numero-1],
+	}
+}
+
+// direccion retorna hacia dónde quiere ir el jugador (teclas o cruceta primero, si no el stick)
+func (j *jugador) direccion(h *InputHandler) (dx, dy float64) {
+	for _, a := range j.Acciones {
+		if h.IsActionPressed(a.Arriba) {
+			dy = -1
+		}
+		if h.IsActionPressed(a.Abajo) {
+			dy = 1
+		}
+		if h.IsActionPressed(a.Izquierda) {
+			dx = -1
+		}
+		if h.IsActionPressed(a.Derecha) {
+			dx = 1
+		}
+	}
+
+	// Normalizar movimiento diagonal
+	if dx != 0 && dy != 0 {
+		factor := 0.707 // sqrt(2)/2
+		dx *= factor
+		dy *= factor
+	}
+
+	// Sin teclas ni cruceta, el stick analógico (la inclinación regula la velocidad)
+	for _, a := range j.Acciones {
+		if dx == 0 && dy == 0 {
+			dx, dy = h.Stick(a.Gamepad)
+		}
+	}
+	return dx, dy
+}
+
+// quiereRecoger indica si el jugador pidió recoger en este frame
+func (j *jugador) quiereRecoger(h *InputHandler) bool {
+	for _, a := range j.Acciones {
+		if h.IsActionJustPressed(a.Recoger) {
+			return true
+		}
+	}
+	return false
+}
+
+// quiereEntregar indica si el jugador pidió entregar en este frame
+func (j *jugador) quiereEntregar(h *InputHandler) bool {
+	for _, a := range j.Acciones {
+		if h.IsActionJustPressed(a.Entregar) {
+			return true
+		}
+	}
+	return false
+}
+
+// actualizarJugador mueve al mesero del jugador y ejecuta sus acciones
+func (g *Game) actualizarJugador(j *jugador, deltaTime float64) {
+	dx, dy := j.direccion(g.inputHandler)
+
+	// El teclado tiene prioridad y cancela el destino marcado con el mouse
+	if dx != 0 || dy != 0 {
+		j.objetivo = nil
+		j.Mesero.Mover(dx, dy, deltaTime)
+	} else if j.objetivo != nil {
+		g.seguirObjetivo(j, deltaTime)
+	} else {
+		j.Mesero.Mover(0, 0, deltaTime)
+	}
+
+	if j.quiereRecoger(g.inputHandler) {
+		g.intentarRecoger(j)
+	}
+	if j.quiereEntregar(g.inputHandler) {
+		g.intentarEntregar(j)
+	}
+}
+
+// notificarJugador muestra un mensaje indicando de qué jugador es (solo en co-op)
+func (g *Game) notificarJugador(j *jugador, mensaje string) {
+	if g.cooperativo {
+		mensaje = fmt.Sprintf("J%d: %s", j.Numero, mensaje)
+	}
+	g.mostrarNotificacion(mensaje)
+}
@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"restaurant-concurrency/internal/domain/model"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Línea de tiempo de estados (carriles por cocinero y mesero, abajo al centro)
+const (
+	lineaTiempoX     = 300.0
+	anchoLineaTiempo = 1300.0
+	anchoEtiqueta    = 160.0
+	altoCarril       = 18.0
+)
+
+// coloresEstado asigna un color a cada estado de la línea de tiempo
+// Rojo y naranja son los bloqueos del patrón: productor con barra llena y consumidor con barra vacía
+var coloresEstado = map[model.EstadoGoroutine]color.RGBA{
+	model.EstadoSinDemanda:       {90, 90, 100, 255},
+	model.EstadoSinEntrada:       {150, 110, 60, 255},
+	model.EstadoEsperandoRecurso: {200, 120, 255, 255},
+	model.EstadoCocinando:        {0, 200, 0, 255},
+	model.EstadoBarraLlena:       {220, 40, 40, 255},
+	model.EstadoCaminando:        {100, 150, 255, 255},
+	model.EstadoBarraVacia:       {255, 140, 0, 255},
+	model.EstadoLibre:            {160, 160, 160, 255},
+}
+
+// actualizarLineaTiempo muestra u oculta la línea de tiempo (F8)
+func (g *Game) actualizarLineaTiempo() {
+	if g.inputHandler.IsKeyJustPressed(ebiten.KeyF8) {
+		g.mostrarLineaTiempo = !g.mostrarLineaTiempo
+	}
+}
+
+// reportarMeseros registra el estado de cada mesero: los cocineros reportan desde sus
+// goroutines, pero los meseros se mueven en el loop de la UI
+func (g *Game) reportarMeseros() {
+	for _, j := range g.jugadores {
+		g.service.ReportarEstado(fmt.Sprintf("Mesero %d", j.Numero), g.estadoJugador(j))
+	}
+	for _, m := range g.meserosIA {
+		g.service.ReportarEstado("Mesero "+m.etiqueta(), g.estadoMeseroIA(m))
+	}
+}
+
+// estadoJugador deduce el estado del mesero de un jugador
+func (g *Game) estadoJugador(j *jugador) model.EstadoGoroutine {
+	switch {
+	case j.Mesero.Estado == model.MeseroCaminando:
+		return model.EstadoCaminando
+	case !j.Mesero.BandejaLlena() && g.meseroEnBarra(j.Mesero) && g.service.GetEstadoBarra() == 0:
+		return model.EstadoBarraVacia
+	}
+	return model.EstadoLibre
+}
+
+// estadoMeseroIA deduce el estado de un mesero automático
+// Sin plato y con mesas esperando, si la barra está vacía está esperando a los cocineros
+func (g *Game) estadoMeseroIA(m *meseroIA) model.EstadoGoroutine {
+	switch {
+	case m.anim.Estado != MeseroEsperando:
+		return model.EstadoCaminando
+	case m.fase == iaLibre && m.plato == nil && g.service.GetEstadoBarra() == 0:
+		if _, ok := g.mesaParaMeseroIA(m); ok {
+			return model.EstadoBarraVacia
+		}
+	}
+	return model.EstadoLibre
+}
+
+// dibujarLineaTiempo dibuja un carril por cocinero y mesero con sus estados en los
+// últimos segundos (el borde derecho es el instante actual)
+func (g *Game) dibujarLineaTiempo(screen *ebiten.Image) {
+	if !g.mostrarLineaTiempo {
+		return
+	}
+	carriles := g.service.GetLineaTiempo()
+	ahora := g.service.Ahora()
+	inicio := ahora.Add(-model.VentanaLineaTiempo)
+
+	alto := float32(60 + altoCarril*len(carriles))
+	y0 := float32(g.height) - alto - 20
+	vector.DrawFilledRect(screen, lineaTiempoX, y0, anchoLineaTiempo, alto, color.RGBA{15, 15, 25, 235}, false)
+	vector.StrokeRect(screen, lineaTiempoX, y0, anchoLineaTiempo, alto, 2, color.RGBA{255, 255, 0, 255}, false)
+
+	x, y := int(lineaTiempoX)+10, int(y0)+8
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("LINEA DE TIEMPO (ultimos %v)  [F8] Ocultar", model.VentanaLineaTiempo), x, y)
+	y += 22
+
+	pistaX := float32(lineaTiempoX + anchoEtiqueta)
+	pistaW := float32(anchoLineaTiempo - anchoEtiqueta - 20)
+	escala := pistaW / float32(model.VentanaLineaTiempo.Seconds())
+	for _, carril := range carriles {
+		ebitenutil.DebugPrintAt(screen, carril.Quien, x, y)
+		vector.DrawFilledRect(screen, pistaX, float32(y+2), pistaW, altoCarril-4, color.RGBA{40, 40, 50, 255}, false)
+		for _, tramo := range carril.Tramos {
+			desde := pistaX + float32(tramo.Desde.Sub(inicio).Seconds())*escala
+			hasta := pistaX + float32(tramo.Hasta.Sub(inicio).Seconds())*escala
+			vector.DrawFilledRect(screen, desde, float32(y+2), max(1, hasta-desde), altoCarril-4, coloresEstado[tramo.Estado], false)
+		}
+		y += int(altoCarril)
+	}
+
+	// Leyenda
+	y += 6
+	for _, estado := range model.EstadosGoroutine {
+		vector.DrawFilledRect(screen, float32(x), float32(y+3), 10, 10, coloresEstado[estado], false)
+		ebitenutil.DebugPrintAt(screen, string(estado), x+14, y)
+		x += 24 + 7*len(estado)
+	}
+}
@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"restaurant-concurrency/internal/domain/model"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// actualizarMenu mueve la selección de nivel y arranca el elegido con ENTER
+func (g *Game) actualizarMenu() {
+	if g.inputHandler.IsActionJustPressed(ActionArriba) || g.inputHandler.IsKeyJustPressed(ebiten.KeyArrowUp) {
+		g.seleccionMenu = (g.seleccionMenu - 1 + len(g.niveles)) % len(g.niveles)
+	}
+	if g.inputHandler.IsActionJustPressed(ActionAbajo) || g.inputHandler.IsKeyJustPressed(ebiten.KeyArrowDown) {
+		g.seleccionMenu = (g.seleccionMenu + 1) % len(g.niveles)
+	}
+	if g.inputHandler.IsConfirmarJustPressed() {
+		g.iniciarNivel(g.seleccionMenu)
+	}
+	if g.inputHandler.IsKeyJustPressed(ebiten.KeyF1) {
+		g.abrirControles()
+	}
+	if g.inputHandler.IsKeyJustPressed(ebiten.KeyF3) {
+		g.cooperativo = !g.cooperativo
+	}
+	if g.inputHandler.IsKeyJustPressed(ebiten.KeyF4) {
+		g.rol = (g.rol + 1) % 2
+	}
+	if g.inputHandler.IsKeyJustPressed(ebiten.KeyF6) {
+		g.siguienteEscenario()
+	}
+	if g.inputHandler.IsKeyJustPressed(ebiten.KeyF7) {
+		g.escenario.Corregido = !g.escenario.Corregido
+	}
+	if g.inputHandler.IsActionJustPressed(ActionSalir) {
+		g.handleClose()
+	}
+}
+
+// describirEtapas resume el pipeline de la cocina (ej. "Preparacion x2 > Hornalla x2 > Emplatado x1")
+func describirEtapas(etapas []model.EtapaCocina) string {
+	partes := make([]string, 0, len(etapas))
+	for _, etapa := range etapas {
+		partes = append(partes, fmt.Sprintf("%s x%d", etapa.Nombre, etapa.Trabajadores))
+	}
+	return strings.Join(partes, " > ")
+}
+
+// dibujarMenu dibuja la lista de niveles con los datos del seleccionado
+func (g *Game) dibujarMenu(screen *ebiten.Image) {
+	panelW, panelH := 520, 254+len(g.niveles)*20
+	if g.avisoPerfil != "" {
+		panelH += 18
+	}
+	x := g.width/2 - panelW/2
+	y := g.height/2 - panelH/2
+
+	vector.DrawFilledRect(screen, float32(x), float32(y), float32(panelW), float32(panelH), color.RGBA{20, 20, 30, 230}, false)
+	vector.StrokeRect(screen, float32(x), float32(y), float32(panelW), float32(panelH), 3, color.RGBA{255, 255, 0, 255}, false)
+
+	x += 20
+	y += 20
+	ebitenutil.DebugPrintAt(screen, "RESTAURANTE CONCURRENTE - SELECCION DE NIVEL", x, y)
+	y += 30
+
+	for i, nivel := range g.niveles {
+		cursor := "  "
+		if i == g.seleccionMenu {
+			cursor = "> "
+		}
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%s%d. %s", cursor, nivel.Numero, nivel.Nombre), x, y)
+		y += 20
+	}
+	y += 10
+
+	// Detalle del nivel seleccionado
+	nivel := g.niveles[g.seleccionMenu]
+	if len(nivel.Etapas) > 0 {
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Mesas: %d  Barra: %d  Paciencia: %v",
+			nivel.NumMesas, nivel.CapacidadBarra, nivel.Paciencia), x, y)
+		y += 18
+		ebitenutil.DebugPrintAt(screen, "Cocina: "+describirEtapas(nivel.Etapas), x, y)
+	} else {
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Mesas: %d  Cocineros: %d  Barra: %d  Paciencia: %v",
+			nivel.NumMesas, nivel.NumCocineros, nivel.CapacidadBarra, nivel.Paciencia), x, y)
+	}
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Llegadas: %s  Bandeja: %d platos", nivel.Llegadas.Tipo, nivel.CapacidadBandeja), x, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Turno: %v  Meta: $%.2f  Max perdidos: %d",
+		nivel.DuracionTurno, nivel.MetaDinero, nivel.MaxPerdidos), x, y)
+	y += 18
+	modo := "1 jugador"
+	if g.cooperativo {
+		modo = "2 jugadores (co-op)"
+	}
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Modo: %s   [F3] Cambiar", modo), x, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Rol: %s   [F4] Cambiar", g.rol), x, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Escenario: %s, %s   [F6] Cambiar  [F7] Corregir",
+		g.escenario.Tipo, g.describirCorreccion()), x, y)
+	y += 30
+	if g.avisoPerfil != "" {
+		ebitenutil.DebugPrintAt(screen, g.avisoPerfil+"  [F1] Ver", x, y)
+		y += 18
+	}
+	entrada := g.inputHandler
+	if entrada.UsandoGamepad() {
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[CRUZ] Elegir   [A] Jugar   [%s] Salir", entrada.EtiquetaAccion(ActionSalir)), x, y)
+		return
+	}
+	controles := entrada.Controles()
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s/%s] Elegir   [ENTER] Jugar   [F1] Controles   [%s] Salir",
+		controles.Etiqueta(ActionArriba), controles.Etiqueta(ActionAbajo), controles.Etiqueta(ActionSalir)), x, y)
+}
@@ -0,0 +1,167 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"restaurant-concurrency/internal/domain/model"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// meserosIA es cuántos meseros automáticos atienden cuando el jugador es el cocinero
+const meserosIA = 2
+
+var tinteMeseroIA = color.RGBA{255, 200, 140, 255}
+
+// faseIA es la tarea en curso de un mesero automático
+type faseIA int
+
+const (
+	iaLibre       faseIA = iota // Sin tarea (esperando trabajo o volviendo a su lugar)
+	iaYendoABarra               // Va a recoger un plato
+	iaLlevando                  // Lleva un plato a una mesa reservada
+)
+
+// meseroIA es un mesero automático: CONSUME de la barra y entrega en las mesas
+// Usa MeseroAnimado para caminar (con rutas A*) y el servicio para recoger y entregar
+type meseroIA struct {
+	anim   *MeseroAnimado
+	fase   faseIA
+	plato  *model.Plato
+	mesaID int // Mesa a la que lleva el plato (reservada para que otro no vaya)
+}
+
+// crearMeserosIA ubica a los meseros automáticos en sus lugares de inicio
+func (g *Game) crearMeserosIA() []*meseroIA {
+	mapa := g.construirMapa()
+	meseros := make([]*meseroIA, 0, meserosIA)
+	for i := 0; i < meserosIA; i++ {
+		pos := posicionesJugador[i%len(posicionesJugador)]
+		anim := NewMeseroAnimado(i+1, pos[0], pos[1])
+		anim.SetMapa(mapa)
+		meseros = append(meseros, &meseroIA{anim: anim, mesaID: -1})
+	}
+	return meseros
+}
+
+// actualizarMeserosIA avanza la animación de cada mesero y decide su próxima tarea al llegar
+func (g *Game) actualizarMeserosIA(deltaTime float64) {
+	for _, m := range g.meserosIA {
+		m.anim.Actualizar(deltaTime)
+		if m.anim.Estado == MeseroEsperando || m.anim.Estado == MeseroRegresando {
+			g.decidirMeseroIA(m)
+		}
+	}
+}
+
+// decidirMeseroIA completa lo que el mesero fue a hacer y elige lo siguiente
+func (g *Game) decidirMeseroIA(m *meseroIA) {
+	llego := m.anim.Estado == MeseroEsperando
+
+	switch {
+	case llego && m.fase == iaYendoABarra:
+		m.fase = iaLibre
+		if plato, ok := g.service.IntentarRecogerPlato(); ok {
+			m.plato = plato
+			m.anim.TomarPlato(plato.ID)
+			return
+		}
+
+	case llego && m.fase == iaLlevando:
+		m.fase = iaLibre
+		m.mesaID = -1
+		if _, ok := g.service.EntregarPlatoAMesa(*m.plato, m.anim.X, m.anim.Y, 100); ok {
+			m.plato = nil
+			m.anim.TieneAlgo = false
+		}
+	}
+
+	if m.fase != iaLibre {
+		return
+	}
+
+	// Con plato en mano: buscar una mesa esperando que nadie más esté atendiendo
+	if m.plato != nil {
+		// Sin camino a la mesa se deja la tarea: la mesa queda libre para otro mesero
+		mesa, ok := g.mesaParaMeseroIA(m)
+		if ok && m.anim.LlevarACliente(mesa.PosX+tamanoMesa+offsetAtencionX, mesa.PosY+tamanoMesa/2) {
+			m.mesaID = mesa.ID
+			m.fase = iaLlevando
+		}
+		return
+	}
+
+	// Manos libres: ir a la barra solo si hay platos y alguna mesa sin atender
+	if g.service.GetEstadoBarra() > 0 {
+		if _, ok := g.mesaParaMeseroIA(m); ok {
+			barraX, barraY := g.posicionBarra()
+			if m.anim.IrABarra(barraX+30, barraY+90) {
+				m.fase = iaYendoABarra
+			}
+			return
+		}
+	}
+
+	// Sin trabajo: volver a su lugar
+	if llego && math.Hypot(m.anim.X-m.anim.InicioX, m.anim.Y-m.anim.InicioY) > radioLlegada {
+		m.anim.Regresar()
+	}
+}
+
+// mesaParaMeseroIA elige la mesa con menos paciencia entre las que nadie tiene reservada
+func (g *Game) mesaParaMeseroIA(m *meseroIA) (model.MesaSnapshot, bool) {
+	reservadas := make(map[int]bool, len(g.meserosIA))
+	for _, otro := range g.meserosIA {
+		if otro != m && otro.fase == iaLlevando {
+			reservadas[otro.mesaID] = true
+		}
+	}
+
+	var elegida model.MesaSnapshot
+	encontrada := false
+	for _, mesa := range g.service.GetMesas() {
+		if mesa.ClientesActivos == 0 || mesa.TienePlato || reservadas[mesa.ID] {
+			continue
+		}
+		if !encontrada || mesa.NivelPaciencia < elegida.NivelPaciencia {
+			elegida = mesa
+			encontrada = true
+		}
+	}
+	return elegida, encontrada
+}
+
+// dibujarMeserosIA dibuja a los meseros automáticos con el renderer del mesero del jugador
+func (g *Game) dibujarMeserosIA(screen *ebiten.Image) {
+	for _, m := range g.meserosIA {
+		g.renderer.DibujarMesero(screen, m.meseroParaDibujar(), tinteMeseroIA, m.etiqueta())
+	}
+}
+
+// meseroParaDibujar adapta un mesero automático al modelo que usa el renderer
+func (m *meseroIA) meseroParaDibujar() *model.Mesero {
+	mesero := &model.Mesero{PosX: m.anim.X, PosY: m.anim.Y}
+	if m.plato != nil {
+		mesero.Bandeja = []model.Plato{*m.plato}
+	}
+	return mesero
+}
+
+// descripcion retorna la tarea del mesero automático para el panel de estado
+func (m *meseroIA) descripcion() string {
+	switch {
+	case m.fase == iaYendoABarra:
+		return "Va a la barra"
+	case m.fase == iaLlevando:
+		return fmt.Sprintf("Lleva plato #%d a mesa %d", m.plato.ID, m.mesaID)
+	case m.plato != nil:
+		return fmt.Sprintf("Plato #%d, esperando mesa", m.plato.ID)
+	}
+	return "Libre"
+}
+
+// etiqueta retorna el nombre que se muestra sobre el mesero automático
+func (m *meseroIA) etiqueta() string {
+	return fmt.Sprintf("IA%d", m.anim.ID)
+}
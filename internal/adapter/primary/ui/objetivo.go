@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"math"
+	"restaurant-concurrency/internal/domain/model"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// accionObjetivo es lo que hace el mesero al llegar al destino marcado con el mouse
+type accionObjetivo int
+
+const (
+	objetivoCaminar  accionObjetivo = iota // Solo caminar hasta el punto
+	objetivoRecoger                        // Recoger un plato de la barra
+	objetivoEntregar                       // Entregar el plato en una mesa
+)
+
+const (
+	tamanoMesa      = 64.0 // Sprite de mesa escalado (32x32 * 2)
+	anchoSlotBarra  = 75.0 // Slot de 60 + separación de 15 (coincidente con Renderer)
+	separacionBarra = 15.0
+	altoBarra       = 64.0
+	radioLlegada    = 4.0  // Distancia a la que se considera que llegó
+	offsetAtencionX = 20.0 // Distancia a la derecha de la mesa donde se para el mesero a entregar
+	celdaMapa       = 16.0 // Tamaño de celda de la grilla de rutas
+)
+
+// objetivoMesero es un destino marcado con click
+type objetivoMesero struct {
+	X, Y   float64
+	Accion accionObjetivo
+	ruta   []model.Punto // Puntos que faltan recorrer (el último es el destino)
+}
+
+// construirMapa arma el mapa caminable con las mesas, la barra, las zonas de la cocina y los equipos del turno actual
+func (g *Game) construirMapa() *model.Mapa {
+	obstaculos := make([]model.Rect, 0, 8)
+	for _, mesa := range g.service.GetMesas() {
+		obstaculos = append(obstaculos, model.Rect{X: mesa.PosX, Y: mesa.PosY, W: tamanoMesa, H: tamanoMesa})
+	}
+
+	obstaculos = append(obstaculos, g.rectBarra())
+	obstaculos = append(obstaculos, g.zonasCocina()...)
+	obstaculos = append(obstaculos, g.zonasEquipos()...)
+	obstaculos = append(obstaculos, g.zonaEscenario()...)
+
+	return model.NewMapa(float64(g.width), float64(g.height), celdaMapa, obstaculos, model.AnchoMesero, model.AltoMesero)
+}
+
+// rectBarra retorna el área que ocupa la barra: es obstáculo para las rutas y zona de click
+// El último slot no tiene separación a la derecha
+func (g *Game) rectBarra() model.Rect {
+	barraX, barraY := g.posicionBarra()
+	anchoBarra := float64(g.service.GetCapacidadBarra())*anchoSlotBarra - separacionBarra
+	return model.Rect{X: barraX, Y: barraY, W: anchoBarra, H: altoBarra}
+}
+
+// irA marca un destino y calcula la ruta esquivando mesas y barra
+func (g *Game) irA(j *jugador, x, y float64, accion accionObjetivo) {
+	ruta := j.Mesero.Mapa.Ruta(j.Mesero.PosX, j.Mesero.PosY, x, y)
+	if ruta == nil {
+		j.objetivo = nil
+		g.notificarJugador(j, "No se puede llegar ahi")
+		return
+	}
+	destino := ruta[len(ruta)-1]
+	j.objetivo = &objetivoMesero{X: destino.X, Y: destino.Y, Accion: accion, ruta: ruta}
+}
+
+// procesarClick convierte un click izquierdo en un destino para el mesero del jugador 1:
+// sobre la barra va a recoger, sobre una mesa va a entregar y en el piso solo camina
+func (g *Game) procesarClick() {
+	if !g.inputHandler.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		return
+	}
+	j := g.jugadores[0]
+	mx, my := g.inputHandler.MousePosition()
+	x, y := float64(mx), float64(my)
+
+	if barra := g.rectBarra(); x >= barra.X && x < barra.X+barra.W && y >= barra.Y && y < barra.Y+barra.H {
+		// Punto de recogida debajo del inicio de la barra (dentro del radio de meseroEnBarra)
+		g.irA(j, barra.X+30, barra.Y+90, objetivoRecoger)
+		return
+	}
+
+	for _, mesa := range g.service.GetMesas() {
+		if x >= mesa.PosX && x < mesa.PosX+tamanoMesa && y >= mesa.PosY && y < mesa.PosY+tamanoMesa {
+			// Punto de atención a la derecha de la mesa (dentro del rango de entrega)
+			g.irA(j, mesa.PosX+tamanoMesa+offsetAtencionX, mesa.PosY+tamanoMesa/2, objetivoEntregar)
+			return
+		}
+	}
+
+	g.irA(j, x, y, objetivoCaminar)
+}
+
+// seguirObjetivo mueve al mesero por la ruta y ejecuta la acción al llegar al destino
+func (g *Game) seguirObjetivo(j *jugador, deltaTime float64) {
+	siguiente := j.objetivo.ruta[0]
+	dx := siguiente.X - j.Mesero.PosX
+	dy := siguiente.Y - j.Mesero.PosY
+	distancia := math.Hypot(dx, dy)
+
+	if distancia > radioLlegada {
+		// No pasarse del punto en el último paso
+		paso := math.Min(1, distancia/(j.Mesero.VelocidadEfectiva()*deltaTime))
+		j.Mesero.Mover(dx/distancia*paso, dy/distancia*paso, deltaTime)
+		return
+	}
+
+	if len(j.objetivo.ruta) > 1 {
+		j.objetivo.ruta = j.objetivo.ruta[1:]
+		return
+	}
+
+	accion := j.objetivo.Accion
+	j.objetivo = nil
+	j.Mesero.Mover(0, 0, deltaTime)
+
+	switch accion {
+	case objetivoRecoger:
+		g.intentarRecoger(j)
+	case objetivoEntregar:
+		g.intentarEntregar(j)
+	}
+}
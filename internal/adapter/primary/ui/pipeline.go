@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"image/color"
+	"restaurant-concurrency/internal/domain/model"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Zonas del pipeline de la cocina (a la derecha del salón, debajo de las estaciones del jugador)
+const (
+	etapaX          = 1200.0
+	etapaY          = 320.0
+	anchoEtapa      = 200.0
+	altoEtapa       = 100.0
+	separacionEtapa = 240.0 // Entre el inicio de una zona y la siguiente (el resto es la flecha)
+	filaEtapa       = 150.0
+	etapasPorFila   = 3
+)
+
+// posicionEtapa retorna la esquina superior izquierda de la zona de una etapa
+func posicionEtapa(i int) (x, y float64) {
+	return etapaX + float64(i%etapasPorFila)*separacionEtapa, etapaY + float64(i/etapasPorFila)*filaEtapa
+}
+
+// zonasCocina retorna el área de cada etapa del pipeline (obstáculos para los meseros)
+func (g *Game) zonasCocina() []model.Rect {
+	etapas := g.service.GetEtapas()
+	zonas := make([]model.Rect, 0, len(etapas))
+	for i := range etapas {
+		x, y := posicionEtapa(i)
+		zonas = append(zonas, model.Rect{X: x, Y: y, W: anchoEtapa, H: altoEtapa})
+	}
+	return zonas
+}
+
+// dibujarPipeline dibuja cada etapa de la cocina como una zona, con flechas hacia la siguiente
+func (g *Game) dibujarPipeline(screen *ebiten.Image) {
+	etapas := g.service.GetEtapas()
+	if len(etapas) == 0 {
+		return
+	}
+
+	ebitenutil.DebugPrintAt(screen, "COCINA (PIPELINE)", int(etapaX), int(etapaY-20))
+	for i, etapa := range etapas {
+		x, y := posicionEtapa(i)
+		g.renderer.DibujarEtapa(screen, float32(x), float32(y), anchoEtapa, altoEtapa, etapa)
+
+		// Flecha hacia la etapa siguiente (en la misma fila) o, en la última, hacia la barra
+		medio := float32(y + altoEtapa/2)
+		switch {
+		case i == len(etapas)-1:
+			ebitenutil.DebugPrintAt(screen, "-> BARRA", int(x+8), int(y+altoEtapa+20))
+		case (i+1)%etapasPorFila != 0:
+			inicio := float32(x + anchoEtapa + 5)
+			fin := float32(x + separacionEtapa - 5)
+			vector.StrokeLine(screen, inicio, medio, fin, medio, 3, color.White, false)
+			vector.StrokeLine(screen, fin-8, medio-6, fin, medio, 3, color.White, false)
+			vector.StrokeLine(screen, fin-8, medio+6, fin, medio, 3, color.White, false)
+		}
+	}
+}
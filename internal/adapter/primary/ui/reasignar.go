@@ -0,0 +1,149 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// AlmacenControles persiste el perfil de controles (lo implementa infraestructura)
+type AlmacenControles interface {
+	Cargar() (map[string][]string, error)
+	Guardar(perfil map[string][]string) error
+}
+
+// SetAlmacenControles carga el perfil guardado y lo usa para guardar los cambios
+// Un perfil de antes del co-op se migra y se vuelve a guardar
+// Si el perfil es inválido se siguen usando los controles por defecto, se avisa en el menú
+// y se retorna el error
+func (g *Game) SetAlmacenControles(almacen AlmacenControles) error {
+	g.almacenControles = almacen
+
+	perfil, err := almacen.Cargar()
+	if err != nil {
+		g.avisoPerfil = "No se pudo leer el perfil de controles: se usan los de por defecto"
+		return err
+	}
+	controles, migradas, err := CargarControles(perfil)
+	if err != nil {
+		g.avisoPerfil = "Perfil de controles invalido: se usan los de por defecto"
+		return err
+	}
+	g.inputHandler.SetControles(controles)
+	if migradas > 0 {
+		g.avisoPerfil = fmt.Sprintf("Perfil de controles actualizado: %d teclas pasaron al J2", migradas)
+		return almacen.Guardar(controles.Perfil())
+	}
+	return nil
+}
+
+// abrirControles muestra la pantalla de reasignación de teclas (con el aviso del perfil, si hay)
+func (g *Game) abrirControles() {
+	g.enControles = true
+	g.seleccionControl = 0
+	g.esperandoTecla = false
+	g.avisoControles = g.avisoPerfil
+}
+
+// actualizarControles navega las acciones y captura la tecla nueva de la elegida
+// La navegación usa flechas, ENTER y ESC fijos para no quedar sin forma de salir
+func (g *Game) actualizarControles() {
+	controles := g.inputHandler.Controles()
+
+	if g.esperandoTecla {
+		g.capturarTecla(controles)
+		return
+	}
+
+	switch {
+	case g.inputHandler.IsKeyJustPressed(ebiten.KeyArrowUp):
+		g.seleccionControl = (g.seleccionControl - 1 + len(accionesConfigurables)) % len(accionesConfigurables)
+	case g.inputHandler.IsKeyJustPressed(ebiten.KeyArrowDown):
+		g.seleccionControl = (g.seleccionControl + 1) % len(accionesConfigurables)
+	case g.inputHandler.IsKeyJustPressed(ebiten.KeyEnter):
+		g.esperandoTecla = true
+		g.avisoControles = ""
+	case g.inputHandler.IsKeyJustPressed(ebiten.KeyF2):
+		g.inputHandler.SetControles(ControlesPorDefecto())
+		g.avisoControles = "Controles por defecto restaurados"
+	case g.inputHandler.IsKeyJustPressed(ebiten.KeyEscape):
+		g.enControles = false
+		g.guardarControles()
+	}
+}
+
+// capturarTecla asigna la primera tecla presionada a la acción elegida
+func (g *Game) capturarTecla(controles *Controles) {
+	teclas := inpututil.AppendJustPressedKeys(nil)
+	if len(teclas) == 0 {
+		return
+	}
+	g.esperandoTecla = false
+
+	tecla := teclas[0]
+	if tecla == ebiten.KeyEscape {
+		g.avisoControles = "Cambio cancelado"
+		return
+	}
+
+	accion := accionesConfigurables[g.seleccionControl]
+	if err := controles.Asignar(accion, tecla); err != nil {
+		g.avisoControles = err.Error()
+		return
+	}
+	g.avisoControles = fmt.Sprintf("%s: [%s]", infoAcciones[accion].Descripcion, tecla)
+}
+
+// guardarControles persiste el perfil actual si hay almacén configurado
+// Si falla, la pantalla queda abierta mostrando el error
+func (g *Game) guardarControles() {
+	if g.almacenControles == nil {
+		return
+	}
+	if err := g.almacenControles.Guardar(g.inputHandler.Controles().Perfil()); err != nil {
+		g.enControles = true
+		g.avisoControles = fmt.Sprintf("No se pudo guardar: %v", err)
+		return
+	}
+	g.avisoPerfil = ""
+}
+
+// dibujarControles dibuja la lista de acciones con sus teclas
+func (g *Game) dibujarControles(screen *ebiten.Image) {
+	panelW, panelH := 460, 150+len(accionesConfigurables)*20
+	x := g.width/2 - panelW/2
+	y := g.height/2 - panelH/2
+
+	vector.DrawFilledRect(screen, float32(x), float32(y), float32(panelW), float32(panelH), color.RGBA{20, 20, 30, 230}, false)
+	vector.StrokeRect(screen, float32(x), float32(y), float32(panelW), float32(panelH), 3, color.RGBA{255, 255, 0, 255}, false)
+
+	x += 20
+	y += 20
+	ebitenutil.DebugPrintAt(screen, "CONTROLES", x, y)
+	y += 30
+
+	controles := g.inputHandler.Controles()
+	for i, accion := range accionesConfigurables {
+		cursor := "  "
+		etiqueta := controles.Etiqueta(accion)
+		if i == g.seleccionControl {
+			cursor = "> "
+			if g.esperandoTecla {
+				etiqueta = "presiona una tecla..."
+			}
+		}
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%s%-24s %s", cursor, infoAcciones[accion].Descripcion, etiqueta), x, y)
+		y += 20
+	}
+	y += 10
+	ebitenutil.DebugPrintAt(screen, g.avisoControles, x, y)
+	y += 20
+
+	ebitenutil.DebugPrintAt(screen, "[ARRIBA/ABAJO] Elegir   [ENTER] Cambiar tecla", x, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, "[F2] Restaurar por defecto   [ESC] Guardar y volver", x, y)
+}
@@ -5,6 +5,7 @@ import (
 	"image/color"
 	"math"
 	"restaurant-concurrency/internal/domain/model"
+	"strings"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
@@ -113,7 +114,11 @@ func (r *Renderer) DibujarMesa(screen *ebiten.Image, mesa model.MesaSnapshot) {
 	}
 }
 
-func (r *Renderer) DibujarBarra(screen *ebiten.Image, x, y float32, ocupado, capacidad int) {
+// DibujarBarra dibuja cada lugar de la barra con el plato que tiene: número, cocinero, tipo y
+// edad, coloreado de verde (recién hecho) a rojo (frío); el próximo a recogerse va resaltado
+func (r *Renderer) DibujarBarra(screen *ebiten.Image, x, y float32, platos []model.PlatoEnBarra, capacidad int) {
+	ocupado := len(platos)
+
 	// Título de la barra - Buffer del patrón Productor-Consumidor
 	ebitenutil.DebugPrintAt(screen, "BARRA", int(x-50), int(y-30))
 	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Platos disponibles: %d/%d", ocupado, capacidad), int(x-50), int(y-15))
@@ -148,18 +153,51 @@ func (r *Renderer) DibujarBarra(screen *ebiten.Image, x, y float32, ocupado, cap
 			vector.StrokeRect(screen, posX, y, slotWidth, 50, 2, color.White, false)
 		}
 
-		// Dibujar sprite de plato si el slot está ocupado
-		if i < ocupado && r.assets.Plato != nil {
-			op := &ebiten.DrawImageOptions{}
-			scale := 1.8
-			op.GeoM.Scale(scale, scale)
-			op.GeoM.Translate(float64(posX+10), float64(y+10))
-			screen.DrawImage(r.assets.Plato, op)
+		if i < ocupado {
+			r.dibujarPlatoEnBarra(screen, posX, y, slotWidth, platos[i])
 		}
 	}
 }
 
-func (r *Renderer) DibujarMesero(screen *ebiten.Image, mesero *model.Mesero) {
+// dibujarPlatoEnBarra dibuja el plato de un lugar de la barra con su identidad debajo
+func (r *Renderer) dibujarPlatoEnBarra(screen *ebiten.Image, x, y, ancho float32, plato model.PlatoEnBarra) {
+	if r.assets.Plato != nil {
+		op := &ebiten.DrawImageOptions{}
+		scale := 1.8
+		op.GeoM.Scale(scale, scale)
+		op.GeoM.Translate(float64(x+10), float64(y+10))
+		screen.DrawImage(r.assets.Plato, op)
+	}
+
+	// Borde según la edad: verde recién hecho, rojo frío
+	edad := interpolarColor(color.RGBA{220, 40, 40, 255}, color.RGBA{0, 200, 0, 255}, plato.Frescura())
+	vector.StrokeRect(screen, x, y, ancho, 50, 3, edad, false)
+
+	// El próximo plato que saldrá del canal (FIFO)
+	if plato.Siguiente {
+		vector.StrokeRect(screen, x-4, y-4, ancho+8, 58, 2, color.RGBA{255, 255, 0, 255}, false)
+		ebitenutil.DebugPrintAt(screen, "SIGUE", int(x+14), int(y-30))
+	}
+
+	cocinero := fmt.Sprintf("C%d", plato.CocineroID)
+	if plato.CocineroID == model.IDCocineroJugador {
+		cocinero = "Jug"
+	}
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("#%d %s", plato.ID, cocinero), int(x), int(y+54))
+	ebitenutil.DebugPrintAt(screen, recortar(plato.Tipo, 10), int(x), int(y+68))
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%.0fs", plato.Edad.Seconds()), int(x), int(y+82))
+}
+
+// recortar acorta un texto a n caracteres para que entre debajo de un lugar de la barra
+func recortar(texto string, n int) string {
+	if runes := []rune(texto); len(runes) > n {
+		return string(runes[:n-1]) + "."
+	}
+	return texto
+}
+
+// DibujarMesero dibuja un mesero con el tinte de su jugador y una etiqueta opcional (ej. "J2")
+func (r *Renderer) DibujarMesero(screen *ebiten.Image, mesero *model.Mesero, tinte color.RGBA, etiqueta string) {
 	x, y := float32(mesero.PosX), float32(mesero.PosY)
 
 	// Dibujar sprite del mesero
@@ -168,9 +206,10 @@ func (r *Renderer) DibujarMesero(screen *ebiten.Image, mesero *model.Mesero) {
 		scale := 3.0 // Hacer más grande para que sea visible
 		op.GeoM.Scale(scale, scale)
 		op.GeoM.Translate(float64(x-48), float64(y-48)) // Centrar (32*3 = 96, 96/2 = 48)
+		op.ColorScale.ScaleWithColor(tinte)
 
 		// Cambiar tono si tiene plato
-		if mesero.TienePlato {
+		if mesero.LlevaPlatos() {
 			op.ColorScale.ScaleWithColor(color.RGBA{255, 220, 180, 255}) // Tono dorado
 		}
 
@@ -178,7 +217,7 @@ func (r *Renderer) DibujarMesero(screen *ebiten.Image, mesero *model.Mesero) {
 	} else {
 		// Fallback: círculo
 		col := color.RGBA{100, 150, 255, 255}
-		if mesero.TienePlato {
+		if mesero.LlevaPlatos() {
 			col = color.RGBA{255, 150, 100, 255}
 		}
 		vector.DrawFilledCircle(screen, x, y, 16, col, false)
@@ -186,41 +225,198 @@ func (r *Renderer) DibujarMesero(screen *ebiten.Image, mesero *model.Mesero) {
 		ebitenutil.DebugPrintAt(screen, "M", int(x-8), int(y-8))
 	}
 
-	// Plato en mano (sprite)
-	if mesero.TienePlato && r.assets.Plato != nil {
-		op := &ebiten.DrawImageOptions{}
-		scale := 2.0
-		op.GeoM.Scale(scale, scale)
-		op.GeoM.Translate(float64(x+20), float64(y-50)) // Arriba a la derecha
-		screen.DrawImage(r.assets.Plato, op)
+	// Platos en la bandeja apilados (sprite), el más antiguo abajo
+	if r.assets.Plato != nil {
+		for i := range mesero.Bandeja {
+			op := &ebiten.DrawImageOptions{}
+			scale := 2.0
+			op.GeoM.Scale(scale, scale)
+			op.GeoM.Translate(float64(x+20), float64(y-50)-float64(i)*10) // Arriba a la derecha
+			screen.DrawImage(r.assets.Plato, op)
+		}
 	}
 
 	// Indicador de texto
 	estado := "Libre"
-	if mesero.TienePlato {
+	if n := len(mesero.Bandeja); n == 1 {
 		estado = "Llevando plato"
+	} else if n > 1 {
+		estado = fmt.Sprintf("Llevando %d platos", n)
 	}
 	ebitenutil.DebugPrintAt(screen, estado, int(x-30), int(y+55))
+	if etiqueta != "" {
+		ebitenutil.DebugPrintAt(screen, etiqueta, int(x-6), int(y-65))
+	}
+}
+
+// DibujarCocinero dibuja al cocinero en la cocina y, si hay cocineros bloqueados porque la
+// barra está llena, un globo de espera con el plato que cada uno tiene en mano
+func (r *Renderer) DibujarCocinero(screen *ebiten.Image, x, y float32, bloqueos []model.BloqueoProductor) {
+	r.dibujarSpriteCocinero(screen, x, y, "C")
+
+	// Etiqueta: CHEF es el productor en el patrón Productor-Consumidor
+	ebitenutil.DebugPrintAt(screen, "CHEF", int(x-10), int(y+120))
+
+	r.dibujarEsperaBarra(screen, x+120, y, bloqueos)
 }
 
-// DibujarCocinero dibuja al cocinero en la cocina
-func (r *Renderer) DibujarCocinero(screen *ebiten.Image, x, y float32) {
-	// Dibujar sprite del cocinero
+// DibujarCocineroIndividual dibuja a un cocinero con su número, el progreso del plato en
+// el fuego y cuántos platos produjo; si espera lugar en la barra muestra el plato en mano
+func (r *Renderer) DibujarCocineroIndividual(screen *ebiten.Image, x, y float32, cocinero model.CocineroSnapshot) {
+	r.dibujarSpriteCocinero(screen, x, y, fmt.Sprint(cocinero.ID))
+	ebitenutil.DebugPrintAt(screen, cocinero.Nombre, int(x+10), int(y+114))
+
+	// Progreso de cocción: verde en el fuego, rojo si el plato está listo pero no entra en la barra
+	vector.DrawFilledRect(screen, x+4, y+132, 104, 8, color.RGBA{60, 60, 70, 255}, false)
+	switch {
+	case cocinero.Bloqueo.Bloqueado:
+		vector.DrawFilledRect(screen, x+4, y+132, 104, 8, color.RGBA{220, 40, 40, 255}, false)
+	case cocinero.TiempoCoccion > 0:
+		vector.DrawFilledRect(screen, x+4, y+132, 104*float32(cocinero.Progreso()), 8, color.RGBA{0, 200, 0, 255}, false)
+	}
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Platos: %d", cocinero.Producidos), int(x+10), int(y+144))
+
+	if cocinero.Bloqueo.Bloqueado {
+		r.dibujarPlatoRetenido(screen, x+72, y-4, cocinero.Bloqueo)
+	}
+}
+
+// dibujarSpriteCocinero dibuja el sprite del cocinero (o un círculo con la inicial si falta)
+func (r *Renderer) dibujarSpriteCocinero(screen *ebiten.Image, x, y float32, inicial string) {
 	if r.assets.Cocinero != nil {
 		op := &ebiten.DrawImageOptions{}
 		scale := 3.5 // Más grande que el mesero
 		op.GeoM.Scale(scale, scale)
 		op.GeoM.Translate(float64(x), float64(y))
 		screen.DrawImage(r.assets.Cocinero, op)
+		return
+	}
+
+	// Fallback: círculo naranja
+	vector.DrawFilledCircle(screen, x+56, y+56, 20, color.RGBA{255, 140, 0, 255}, false)
+	vector.StrokeCircle(screen, x+56, y+56, 20, 2, color.White, false)
+	ebitenutil.DebugPrintAt(screen, inicial, int(x+48), int(y+48))
+}
+
+// dibujarPlatoRetenido dibuja el globo de un cocinero que espera lugar en la barra con su plato
+func (r *Renderer) dibujarPlatoRetenido(screen *ebiten.Image, x, y float32, bloqueo model.BloqueoProductor) {
+	vector.DrawFilledRect(screen, x, y, 74, 36, color.RGBA{40, 15, 15, 230}, false)
+	vector.StrokeRect(screen, x, y, 74, 36, 2, color.RGBA{220, 40, 40, 255}, false)
+	r.dibujarPlatoChico(screen, x+6, y+4)
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("#%d", bloqueo.Plato.ID), int(x+32), int(y+2))
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%.1fs", bloqueo.Espera.Seconds()), int(x+32), int(y+18))
+}
+
+// dibujarPlatoChico dibuja el sprite del plato a escala reducida (o un círculo si falta)
+func (r *Renderer) dibujarPlatoChico(screen *ebiten.Image, x, y float32) {
+	if r.assets.Plato != nil {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Scale(1.5, 1.5)
+		op.GeoM.Translate(float64(x), float64(y))
+		screen.DrawImage(r.assets.Plato, op)
+		return
+	}
+	vector.DrawFilledCircle(screen, x+8, y+10, 7, color.RGBA{255, 200, 0, 255}, false)
+}
+
+// dibujarEsperaBarra dibuja el globo de los productores bloqueados: la barra está llena y
+// cada uno espera con su plato en mano (el send al canal no avanza)
+func (r *Renderer) dibujarEsperaBarra(screen *ebiten.Image, x, y float32, bloqueos []model.BloqueoProductor) {
+	bloqueados := make([]model.BloqueoProductor, 0, len(bloqueos))
+	for _, bloqueo := range bloqueos {
+		if bloqueo.Bloqueado {
+			bloqueados = append(bloqueados, bloqueo)
+		}
+	}
+	if len(bloqueados) == 0 {
+		return
+	}
+
+	alto := float32(26 + 20*len(bloqueados))
+	vector.DrawFilledRect(screen, x, y, 250, alto, color.RGBA{40, 15, 15, 230}, false)
+	vector.StrokeRect(screen, x, y, 250, alto, 2, color.RGBA{220, 40, 40, 255}, false)
+	ebitenutil.DebugPrintAt(screen, "ESPERANDO LUGAR EN LA BARRA", int(x+8), int(y+5))
+
+	for i, bloqueo := range bloqueados {
+		filaY := y + 24 + float32(i)*20
+		r.dibujarPlatoChico(screen, x+8, filaY-2)
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%s #%d  %.1fs",
+			bloqueo.Cocinero, bloqueo.Plato.ID, bloqueo.Espera.Seconds()), int(x+34), int(filaY))
+	}
+}
+
+// DibujarEtapa dibuja una zona de la cocina con la cola de entrada y los trabajadores de la etapa
+// El borde se pone rojo si la cola está llena: la etapa es el cuello de botella del pipeline
+func (r *Renderer) DibujarEtapa(screen *ebiten.Image, x, y, w, h float32, etapa model.EtapaSnapshot) {
+	borde := color.RGBA{255, 140, 0, 255}
+	if etapa.ColaLlena() {
+		borde = color.RGBA{220, 40, 40, 255}
+	}
+	vector.DrawFilledRect(screen, x, y, w, h, color.RGBA{50, 35, 25, 230}, false)
+	vector.StrokeRect(screen, x, y, w, h, 2, borde, false)
+	ebitenutil.DebugPrintAt(screen, strings.ToUpper(etapa.Nombre), int(x+8), int(y+6))
+
+	// Cola de entrada: un cuadro por lugar (la primera etapa arranca con la demanda)
+	if etapa.CapacidadCola > 0 {
+		for i := 0; i < etapa.CapacidadCola; i++ {
+			col := color.RGBA{60, 60, 70, 255}
+			if i < etapa.EnCola {
+				col = color.RGBA{255, 200, 0, 255}
+			}
+			vector.DrawFilledRect(screen, x+8+float32(i)*18, y+26, 14, 14, col, false)
+		}
 	} else {
-		// Fallback: círculo naranja
-		vector.DrawFilledCircle(screen, x+56, y+56, 20, color.RGBA{255, 140, 0, 255}, false)
-		vector.StrokeCircle(screen, x+56, y+56, 20, 2, color.White, false)
-		ebitenutil.DebugPrintAt(screen, "C", int(x+48), int(y+48))
+		ebitenutil.DebugPrintAt(screen, "Arranca con la demanda", int(x+8), int(y+26))
 	}
 
-	// Etiqueta: CHEF es el productor en el patrón Productor-Consumidor
-	ebitenutil.DebugPrintAt(screen, "CHEF", int(x-10), int(y+120))
+	// Trabajadores: naranja si tienen un plato, gris si esperan trabajo
+	for i := 0; i < etapa.Trabajadores; i++ {
+		col := color.RGBA{90, 90, 90, 255}
+		if i < etapa.Ocupados {
+			col = color.RGBA{255, 140, 0, 255}
+		}
+		vector.DrawFilledCircle(screen, x+16+float32(i)*22, y+58, 8, col, false)
+	}
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Procesados: %d", etapa.Procesados), int(x+8), int(y+h-20))
+
+	if etapa.ColaLlena() {
+		ebitenutil.DebugPrintAt(screen, "CUELLO DE BOTELLA", int(x+8), int(y+h+4))
+	}
+}
+
+// DibujarEquipo dibuja un equipo compartido con sus unidades, quién lo usa y la cola de espera
+func (r *Renderer) DibujarEquipo(screen *ebiten.Image, x, y, w, h float32, equipo model.EquipoSnapshot) {
+	borde := color.RGBA{100, 150, 255, 255}
+	if len(equipo.Esperando) > 0 {
+		borde = color.RGBA{220, 40, 40, 255} // Hay contención
+	}
+	vector.DrawFilledRect(screen, x, y, w, h, color.RGBA{25, 30, 45, 230}, false)
+	vector.StrokeRect(screen, x, y, w, h, 2, borde, false)
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%s %d/%d", strings.ToUpper(equipo.Nombre), equipo.EnUso, equipo.Capacidad),
+		int(x+8), int(y+6))
+
+	// Unidades del semáforo: llenas las que están en uso
+	for i := 0; i < equipo.Capacidad; i++ {
+		col := color.RGBA{60, 60, 70, 255}
+		if i < equipo.EnUso {
+			col = color.RGBA{100, 150, 255, 255}
+		}
+		vector.DrawFilledRect(screen, x+8+float32(i)*18, y+26, 14, 14, col, false)
+	}
+
+	ebitenutil.DebugPrintAt(screen, "Usa: "+resumirNombres(equipo.Usando), int(x+8), int(y+46))
+	ebitenutil.DebugPrintAt(screen, "Espera: "+resumirNombres(equipo.Esperando), int(x+8), int(y+64))
+}
+
+// resumirNombres une los nombres mostrando como máximo dos (ej. "Cocinero 1, Cocinero 2 +3")
+func resumirNombres(nombres []string) string {
+	if len(nombres) == 0 {
+		return "-"
+	}
+	if len(nombres) <= 2 {
+		return strings.Join(nombres, ", ")
+	}
+	return fmt.Sprintf("%s +%d", strings.Join(nombres[:2], ", "), len(nombres)-2)
 }
 
 // interpolarColor mezcla dos colores según un factor (0.0 a 1.0)
@@ -233,3 +429,15 @@ func interpolarColor(c1, c2 color.RGBA, factor float64) color.RGBA {
 		A: 255,
 	}
 }
+
+// DibujarObjetivo dibuja el marcador del destino elegido con el mouse
+// (amarillo si al llegar el mesero interactúa con la barra o una mesa)
+func (r *Renderer) DibujarObjetivo(screen *ebiten.Image, x, y float32, interaccion bool) {
+	col := color.RGBA{100, 200, 255, 255}
+	if interaccion {
+		col = color.RGBA{255, 220, 0, 255}
+	}
+	vector.StrokeCircle(screen, x, y, 10, 2, col, false)
+	vector.StrokeLine(screen, x-14, y, x+14, y, 2, col, false)
+	vector.StrokeLine(screen, x, y-14, x, y+14, 2, col, false)
+}
@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"errors"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// ErrCierreSolicitado es el error que retorna Game.Update cuando el usuario cerró el restaurante
+// Permite a main distinguir una salida normal de una falla con errors.Is
+var ErrCierreSolicitado = errors.New("cierre solicitado por usuario")
+
+// actualizarConfirmacion procesa la respuesta a "Cerrar el restaurante? ENTER/N" (A/B con control)
+// Se confirma con ENTER y no con S, que por defecto mueve al jugador hacia abajo
+func (g *Game) actualizarConfirmacion() {
+	if g.inputHandler.IsConfirmarJustPressed() {
+		g.responderSalida()
+		g.confirmarCierre()
+		return
+	}
+	if g.inputHandler.IsKeyJustPressed(ebiten.KeyN) || g.inputHandler.IsCancelarJustPressed() {
+		g.responderSalida()
+	}
+}
+
+// dibujarConfirmacion dibuja el cuadro de confirmación de salida
+func (g *Game) dibujarConfirmacion(screen *ebiten.Image) {
+	panelW, panelH := 300, 70
+	x := g.width/2 - panelW/2
+	y := g.height/2 - panelH/2
+
+	vector.DrawFilledRect(screen, 0, 0, float32(g.width), float32(g.height), color.RGBA{0, 0, 0, 120}, false)
+	vector.DrawFilledRect(screen, float32(x), float32(y), float32(panelW), float32(panelH), color.RGBA{20, 20, 30, 240}, false)
+	vector.StrokeRect(screen, float32(x), float32(y), float32(panelW), float32(panelH), 3, color.RGBA{255, 255, 0, 255}, false)
+
+	ebitenutil.DebugPrintAt(screen, "Cerrar el restaurante? "+g.inputHandler.EtiquetaSiNo(), x+20, y+20)
+	ebitenutil.DebugPrintAt(screen, "(se sirven las mesas que quedan)", x+20, y+40)
+}
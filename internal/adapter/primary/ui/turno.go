@@ -0,0 +1,174 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"restaurant-concurrency/internal/domain/model"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// actualizarTurno avanza el reloj del turno y detecta el fin del servicio
+func (g *Game) actualizarTurno(deltaTime float64) {
+	g.turno.Avanzar(deltaTime)
+
+	_, _, perdidos := g.service.GetMetricas()
+	dinero, _, _ := g.service.GetEconomia()
+	if g.turno.Evaluar(dinero, perdidos) == model.TurnoEnCurso {
+		return
+	}
+
+	// Fin del turno: congelar el restaurante y guardar el resumen
+	g.service.Detener()
+	g.resumen = g.crearResumen()
+}
+
+// crearResumen arma el resumen del turno a partir de las métricas del servicio
+func (g *Game) crearResumen() *model.ResumenTurno {
+	producidos, servidos, perdidos := g.service.GetMetricas()
+	dinero, propinas, satisfaccion := g.service.GetEconomia()
+
+	return &model.ResumenTurno{
+		Resultado:    g.turno.Resultado,
+		Duracion:     g.turno.Transcurrido,
+		Producidos:   producidos,
+		Servidos:     servidos,
+		Perdidos:     perdidos,
+		Dinero:       dinero,
+		Propinas:     propinas,
+		Satisfaccion: satisfaccion,
+	}
+}
+
+// actualizarResumen procesa las opciones de la pantalla de fin de turno
+func (g *Game) actualizarResumen() {
+	if g.inputHandler.IsActionJustPressed(ActionSalir) {
+		g.handleClose()
+		return
+	}
+	if g.inputHandler.IsMenuJustPressed() {
+		g.resumen = nil
+		g.enMenu = true
+		return
+	}
+	if !g.inputHandler.IsConfirmarJustPressed() {
+		return
+	}
+
+	// Avanzar al siguiente nivel si se cumplió la meta; si no, reintentar
+	if g.hayNivelSiguiente() {
+		g.iniciarNivel(g.nivelActual + 1)
+	} else {
+		g.reiniciarTurno()
+	}
+}
+
+// hayNivelSiguiente indica si el turno se ganó y existe un nivel más difícil
+func (g *Game) hayNivelSiguiente() bool {
+	return g.resumen.Resultado == model.TurnoGanado && g.nivelActual+1 < len(g.niveles)
+}
+
+// iniciarNivel carga un nivel en el servicio y comienza un turno nuevo
+func (g *Game) iniciarNivel(indice int) {
+	g.nivelActual = indice
+	nivel := g.niveles[indice]
+	g.service.SetEscenario(g.escenario)
+	g.service.CargarNivel(g.nivelParaRol(nivel))
+	g.turno = nivel.NuevoTurno()
+	g.prepararTurno()
+	g.mostrarNotificacion(fmt.Sprintf("Nivel %d: %s", nivel.Numero, nivel.Nombre))
+}
+
+// nivelParaRol adapta el nivel al rol elegido: como cocinero, el jugador reemplaza a uno de los cocineros
+// (con una cocina por etapas el pipeline se mantiene y el jugador cocina aparte)
+func (g *Game) nivelParaRol(nivel model.Nivel) model.Nivel {
+	if g.rol == rolCocinero {
+		nivel.NumCocineros = max(0, nivel.NumCocineros-1)
+	}
+	return nivel
+}
+
+// reiniciarTurno reinicia el servicio y los meseros sin reiniciar el proceso
+func (g *Game) reiniciarTurno() {
+	g.service.Reset()
+	g.turno.Reiniciar()
+	g.prepararTurno()
+}
+
+// prepararTurno deja a los meseros y la interfaz listos para un turno nuevo
+func (g *Game) prepararTurno() {
+	g.jugadores, g.cocina, g.meserosIA = nil, nil, nil
+	if g.rol == rolCocinero {
+		g.cocina = newCocinaJugador()
+		g.meserosIA = g.crearMeserosIA()
+	} else {
+		g.jugadores = g.crearJugadores()
+	}
+	g.resumen = nil
+	g.enMenu = false
+	g.notificacionFrames = 0
+}
+
+// dibujarResumen dibuja la pantalla de fin de turno
+func (g *Game) dibujarResumen(screen *ebiten.Image) {
+	panelW, panelH := 420, 280+18*(len(g.jugadores)+1)
+	x := g.width/2 - panelW/2
+	y := g.height/2 - panelH/2
+
+	vector.DrawFilledRect(screen, 0, 0, float32(g.width), float32(g.height), color.RGBA{0, 0, 0, 150}, false)
+	vector.DrawFilledRect(screen, float32(x), float32(y), float32(panelW), float32(panelH), color.RGBA{20, 20, 30, 230}, false)
+
+	borde := color.RGBA{0, 200, 0, 255}
+	if g.resumen.Resultado != model.TurnoGanado {
+		borde = color.RGBA{220, 40, 40, 255}
+	}
+	vector.StrokeRect(screen, float32(x), float32(y), float32(panelW), float32(panelH), 3, borde, false)
+
+	r := g.resumen
+	x += 20
+	y += 20
+	ebitenutil.DebugPrintAt(screen, "FIN DEL TURNO", x, y)
+	y += 20
+	ebitenutil.DebugPrintAt(screen, r.Resultado.String(), x, y)
+	y += 30
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Duracion: %d:%02d", int(r.Duracion.Minutes()), int(r.Duracion.Seconds())%60), x, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Producidos: %d", r.Producidos), x, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Servidos: %d", r.Servidos), x, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Perdidos: %d/%d", r.Perdidos, g.turno.MaxPerdidos), x, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Dinero: $%.2f (meta $%.2f)", r.Dinero, g.turno.MetaDinero), x, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Propinas: $%.2f", r.Propinas), x, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Satisfaccion: %.0f%%", r.Satisfaccion*100), x, y)
+	y += 18
+
+	// Puntaje por jugador en co-op (los jugadores siguen vivos hasta el próximo turno)
+	if g.cooperativo {
+		for _, j := range g.jugadores {
+			ebitenutil.DebugPrintAt(screen, fmt.Sprintf("J%d: %d recogidos, %d entregas, $%.2f (propinas $%.2f)",
+				j.Numero, j.Recogidos, j.Entregas, j.Dinero, j.Propinas), x, y)
+			y += 18
+		}
+	}
+	if g.cocina != nil {
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Cocina: %d emplatados (%d perfectos), %d quemados",
+			g.cocina.Emplatados, g.cocina.Perfectos, g.cocina.Quemados), x, y)
+		y += 18
+	}
+	y += 22
+	if g.hayNivelSiguiente() {
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s] Siguiente nivel", g.inputHandler.EtiquetaConfirmar()), x, y)
+	} else {
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s] Reintentar nivel", g.inputHandler.EtiquetaConfirmar()), x, y)
+	}
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s] Menu de niveles", g.inputHandler.EtiquetaMenu()), x, y)
+	y += 18
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("[%s] Salir", g.inputHandler.EtiquetaAccion(ActionSalir)), x, y)
+}
@@ -5,17 +5,42 @@ import (
 	"fmt"
 	"math/rand"
 	"restaurant-concurrency/internal/domain/model"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 // Cocinero es el worker que implementa el PRODUCTOR
 // Este es un adapter secundario que ejecuta la lógica de producción
 type Cocinero struct {
-	id int
+	id               int
+	coccionBase      time.Duration // Tiempo mínimo de cocción
+	coccionVariacion time.Duration // Variación aleatoria sobre la base
+	reloj            *model.Reloj  // Reloj del juego (la cocción se congela en pausa)
+	ingredientes                   // Despensa de la que toma cada receta (opcional)
+	equipos                        // Equipos compartidos que pide cada receta (opcional)
+	estados                        // Registro de estados para la línea de tiempo (opcional)
+	numeracion                     // Numerador de platos compartido por toda la cocina
+
+	// Estado observable desde otras goroutines
+	cocinando   atomic.Bool  // Tiene un plato empezado o por empezar (hasta dejarlo en la barra)
+	abandonados atomic.Int64 // Platos empezados que se perdieron por cancelación
+	producidos  atomic.Int64 // Platos que dejó en la barra
+
+	// Plato en el fuego (para la barra de progreso)
+	coccionMu     sync.Mutex
+	inicioCoccion time.Time // Cero si no está cocinando
+	duracion      time.Duration
+	receta        string
 }
 
-func NewCocinero(id int) *Cocinero {
-	return &Cocinero{id: id}
+func NewCocinero(id int, coccionBase, coccionVariacion time.Duration, reloj *model.Reloj) *Cocinero {
+	return &Cocinero{
+		id:               id,
+		coccionBase:      coccionBase,
+		coccionVariacion: coccionVariacion,
+		reloj:            reloj,
+	}
 }
 
 // Producir ejecuta el loop de producción (goroutine)
@@ -28,8 +53,6 @@ func (c *Cocinero) Producir(
 	barra chan<- model.Plato,
 	verificarDemanda func() bool,
 ) {
-	platoID := 0
-
 	for {
 		select {
 		case <-ctx.Done():
@@ -38,41 +61,128 @@ func (c *Cocinero) Producir(
 
 		default:
 			// Solo producir si hay demanda (clientes esperando)
+			// Queda marcado como cocinando ANTES de mirar la demanda: si el cierre empieza
+			// justo después, CierreTerminado ya lo ve con el plato empezado
+			c.cocinando.Store(true)
 			if !verificarDemanda() {
-				// Espera no bloqueante usando select con time.After
-				select {
-				case <-time.After(500 * time.Millisecond):
-					continue
-				case <-ctx.Done():
+				c.cocinando.Store(false)
+				c.reportar(model.EstadoSinDemanda)
+				// Vuelve a mirar en un rato (con el reloj del juego: en pausa no consulta)
+				if !c.reloj.Esperar(ctx, 500*time.Millisecond) {
 					return
 				}
+				continue
 			}
 
-			// Simular tiempo de cocción (trabajo concurrente) usando time.After
-			tiempoCoccion := time.Duration(1500+rand.Intn(1000)) * time.Millisecond
+			// Tomar los ingredientes (CONSUMIDOR de la despensa): se bloquea si no alcanzan
+			receta, ok := c.tomarIngredientes(ctx, &c.estados)
+			if !ok {
+				c.cocinando.Store(false) // Todavía no había empezado el plato
+				return
+			}
+
+			// Hacer cola por los equipos de la receta (hornos, freidoras)
+			if !c.tomarEquipos(ctx, &c.estados, c.Nombre(), receta.Equipos) {
+				c.abandonar()
+				return
+			}
 
-			select {
-			case <-time.After(tiempoCoccion):
-				// Continuar con la producción
-			case <-ctx.Done():
+			// Simular tiempo de cocción (trabajo concurrente) con el reloj del juego
+			c.reportar(model.EstadoCocinando)
+			tiempoCoccion := c.tiempoCoccion()
+			c.empezarCoccion(receta.Nombre, tiempoCoccion)
+			cocinado := c.reloj.Esperar(ctx, tiempoCoccion)
+			c.terminarCoccion()
+			c.liberarEquipos(c.Nombre(), receta.Equipos)
+			if !cocinado {
+				c.abandonar()
 				return
 			}
 
 			// Crear plato
-			plato := model.NewPlato(platoID, c.id)
+			plato := model.NewPlato(c.nuevoID(), c.id, c.reloj.Ahora())
+			if receta.Nombre != "" {
+				plato.Nombre = receta.Nombre
+			}
 
 			// INTENTAR PONER EN LA BARRA (canal buffered)
 			// Si la barra está llena, SE BLOQUEA aquí hasta que haya espacio
 			// Este es el comportamiento del patrón Productor-Consumidor
-			select {
-			case barra <- plato:
-				fmt.Printf("Cocinero %d preparó plato #%d (tiempo: %.1fs)\n",
-					c.id, platoID, tiempoCoccion.Seconds())
-				platoID++
-
-			case <-ctx.Done():
+			if !c.enviar(ctx, barra, plato, c.reloj) {
+				c.abandonar()
 				return
 			}
+			c.cocinando.Store(false)
+			c.producidos.Add(1)
+			fmt.Printf("Cocinero %d preparó plato #%d (tiempo: %.1fs)\n",
+				c.id, plato.ID, tiempoCoccion.Seconds())
 		}
 	}
 }
+
+// Nombre identifica al cocinero en las colas de los equipos y en la línea de tiempo
+func (c *Cocinero) Nombre() string {
+	return fmt.Sprintf("Cocinero %d", c.id)
+}
+
+// tiempoCoccion calcula la duración de un plato (base + variación aleatoria)
+func (c *Cocinero) tiempoCoccion() time.Duration {
+	if c.coccionVariacion <= 0 {
+		return c.coccionBase
+	}
+	return c.coccionBase + time.Duration(rand.Int63n(int64(c.coccionVariacion)))
+}
+
+// abandonar registra que el plato en curso se pierde por cancelación
+func (c *Cocinero) abandonar() {
+	c.cocinando.Store(false)
+	c.abandonados.Add(1)
+}
+
+// empezarCoccion registra el plato que se pone al fuego
+func (c *Cocinero) empezarCoccion(receta string, duracion time.Duration) {
+	c.coccionMu.Lock()
+	defer c.coccionMu.Unlock()
+	c.inicioCoccion = c.reloj.Ahora()
+	c.duracion = duracion
+	c.receta = receta
+}
+
+// terminarCoccion registra que el plato salió del fuego (listo o abandonado)
+func (c *Cocinero) terminarCoccion() {
+	c.coccionMu.Lock()
+	defer c.coccionMu.Unlock()
+	c.inicioCoccion = time.Time{}
+}
+
+// Snapshot retorna el estado observable del cocinero
+func (c *Cocinero) Snapshot(ahora time.Time) model.CocineroSnapshot {
+	snapshot := model.CocineroSnapshot{
+		ID:         c.id,
+		Nombre:     c.Nombre(),
+		Estado:     c.estadoActual(),
+		Producidos: int(c.producidos.Load()),
+		Bloqueo:    c.BloqueoSalida(ahora),
+	}
+	snapshot.Bloqueo.Cocinero = snapshot.Nombre
+
+	c.coccionMu.Lock()
+	defer c.coccionMu.Unlock()
+	if !c.inicioCoccion.IsZero() {
+		snapshot.Plato = c.receta
+		snapshot.TiempoCoccion = c.duracion
+		snapshot.Transcurrido = min(ahora.Sub(c.inicioCoccion), c.duracion)
+	}
+	return snapshot
+}
+
+// EstaCocinando indica si el cocinero tiene un plato empezado (o por empezar) sin entregar a la barra
+// Mientras espera ingredientes el plato todavía no empezó: no cuenta como cocinando
+func (c *Cocinero) EstaCocinando() bool {
+	return c.cocinando.Load() && !c.EsperandoIngredientes()
+}
+
+// PlatosAbandonados retorna cuántos platos empezados se perdieron por cancelación
+func (c *Cocinero) PlatosAbandonados() int {
+	return int(c.abandonados.Load())
+}
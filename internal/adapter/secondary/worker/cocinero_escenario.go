@@ -0,0 +1,162 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"restaurant-concurrency/internal/domain/model"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// reintentoEscenario es cada cuánto vuelve a probar un cocinero que no hace cola
+const reintentoEscenario = 50 * time.Millisecond
+
+// CocineroEscenario es un cocinero de los escenarios didácticos: toma sus utensilios
+// UNO POR UNO en su propio orden, así que puede quedar en un interbloqueo o pasar hambre
+type CocineroEscenario struct {
+	id           int
+	config       model.CocineroEscenario
+	equipamiento *model.Equipamiento
+	reloj        *model.Reloj
+	estados      // Registro de estados para la línea de tiempo (opcional)
+	numeracion   // Numerador de platos compartido
+
+	// Estado observable desde el vigilante y el cierre
+	cocinando atomic.Bool // Tiene un plato empezado o por empezar (hasta dejarlo en la barra)
+	mu        sync.Mutex
+	esperando string    // Utensilio que está esperando ("" si no espera)
+	desde     time.Time // Desde cuándo lo espera (reloj del juego)
+	platos    int
+}
+
+func NewCocineroEscenario(id int, config model.CocineroEscenario, equipamiento *model.Equipamiento, reloj *model.Reloj) *CocineroEscenario {
+	return &CocineroEscenario{
+		id:           id,
+		config:       config,
+		equipamiento: equipamiento,
+		reloj:        reloj,
+	}
+}
+
+// Producir ejecuta el loop del cocinero (goroutine)
+// Al cancelarse el contexto suelta lo que tenga, aunque esté en un interbloqueo
+func (c *CocineroEscenario) Producir(
+	ctx context.Context,
+	barra chan<- model.Plato,
+	verificarDemanda func() bool,
+) {
+	defer c.cocinando.Store(false)
+
+	for {
+		// Como el cocinero del nivel, queda marcado ANTES de mirar la demanda
+		c.cocinando.Store(true)
+		if !verificarDemanda() {
+			c.cocinando.Store(false)
+			c.reportar(model.EstadoSinDemanda)
+			if !c.reloj.Esperar(ctx, 500*time.Millisecond) {
+				return
+			}
+			continue
+		}
+
+		// Tomar los utensilios de a uno, reteniendo los anteriores (aquí puede formarse el ciclo)
+		tomados := make([]string, 0, len(c.config.Recursos))
+		for i, recurso := range c.config.Recursos {
+			if !c.tomar(ctx, recurso) {
+				c.soltar(tomados)
+				return
+			}
+			tomados = append(tomados, recurso)
+			c.reportar(model.EstadoCocinando)
+			if i == 0 && !c.reloj.Esperar(ctx, c.config.Preparacion) {
+				c.soltar(tomados)
+				return
+			}
+		}
+
+		cocinado := c.reloj.Esperar(ctx, c.config.Trabajo)
+		c.soltar(tomados)
+		if !cocinado {
+			return
+		}
+
+		plato := model.NewPlato(c.nuevoID(), c.id, c.reloj.Ahora())
+		plato.Nombre = "Plato de " + c.config.Nombre
+		if !c.enviar(ctx, barra, plato, c.reloj) {
+			return
+		}
+		c.cocinando.Store(false)
+		c.contarPlato()
+		fmt.Printf("%s preparó plato #%d\n", c.config.Nombre, plato.ID)
+
+		if c.config.Pausa > 0 && !c.reloj.Esperar(ctx, c.config.Pausa) {
+			return
+		}
+	}
+}
+
+// tomar consigue un utensilio haciendo cola o, si reintenta, probando cada tanto sin hacer cola
+func (c *CocineroEscenario) tomar(ctx context.Context, recurso string) bool {
+	c.marcarEspera(recurso)
+	defer c.marcarEspera("")
+	c.reportar(model.EstadoEsperandoRecurso)
+
+	equipo := c.equipamiento.Equipo(recurso)
+	if !c.config.Reintenta {
+		return equipo.Adquirir(ctx, c.config.Nombre, 1)
+	}
+	for !equipo.IntentarAdquirir(c.config.Nombre, 1) {
+		if !c.reloj.Esperar(ctx, reintentoEscenario) {
+			return false
+		}
+	}
+	return true
+}
+
+// soltar devuelve los utensilios tomados
+func (c *CocineroEscenario) soltar(tomados []string) {
+	for _, recurso := range tomados {
+		c.equipamiento.Equipo(recurso).Liberar(c.config.Nombre, 1)
+	}
+}
+
+func (c *CocineroEscenario) marcarEspera(recurso string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.esperando = recurso
+	c.desde = c.reloj.Ahora()
+}
+
+func (c *CocineroEscenario) contarPlato() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.platos++
+}
+
+// Nombre identifica al cocinero en los reportes del vigilante
+func (c *CocineroEscenario) Nombre() string {
+	return c.config.Nombre
+}
+
+// Esperando retorna el utensilio que espera y desde cuándo (ok = false si no espera nada)
+func (c *CocineroEscenario) Esperando() (recurso string, desde time.Time, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.esperando, c.desde, c.esperando != ""
+}
+
+// Platos retorna cuántos platos terminó el cocinero
+func (c *CocineroEscenario) Platos() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.platos
+}
+
+// EstaCocinando indica si tiene un plato empezado y no está esperando un utensilio
+// (esperando puede estar en un interbloqueo o pasando hambre, y nunca terminar)
+func (c *CocineroEscenario) EstaCocinando() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cocinando.Load() && c.esperando == ""
+}
@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"restaurant-concurrency/internal/domain/model"
+	"sync/atomic"
+)
+
+// equipos conecta a un worker de la cocina con los equipos compartidos (hornos, freidoras)
+// Sin equipamiento el worker cocina sin hacer cola (todos en paralelo)
+type equipos struct {
+	equipamiento *model.Equipamiento
+
+	esperandoEquipo atomic.Bool // En la cola de algún equipo
+}
+
+// UsarEquipos hace que cada plato tome los equipos que pide antes de cocinarse
+// Debe llamarse antes de arrancar la goroutine del worker
+func (e *equipos) UsarEquipos(equipamiento *model.Equipamiento) {
+	e.equipamiento = equipamiento
+}
+
+// tomarEquipos toma los equipos requeridos; si alguno está ocupado reporta la espera y
+// hace cola hasta tenerlos todos
+// Retorna false si el contexto se canceló mientras esperaba
+func (e *equipos) tomarEquipos(ctx context.Context, est *estados, quien string, requeridos map[string]int) bool {
+	if e.equipamiento == nil || len(requeridos) == 0 {
+		return true
+	}
+	if e.equipamiento.IntentarAdquirir(quien, requeridos) {
+		return true
+	}
+
+	est.reportar(model.EstadoEsperandoRecurso)
+	e.esperandoEquipo.Store(true)
+	defer e.esperandoEquipo.Store(false)
+	return e.equipamiento.Adquirir(ctx, quien, requeridos)
+}
+
+// liberarEquipos devuelve los equipos al terminar (o abandonar) el plato
+func (e *equipos) liberarEquipos(quien string, requeridos map[string]int) {
+	if e.equipamiento == nil || len(requeridos) == 0 {
+		return
+	}
+	e.equipamiento.Liberar(quien, requeridos)
+}
+
+// EsperandoEquipo indica si el worker está en la cola de un equipo
+func (e *equipos) EsperandoEquipo() bool {
+	return e.esperandoEquipo.Load()
+}
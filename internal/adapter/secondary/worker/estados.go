@@ -0,0 +1,118 @@
+package worker
+
+import (
+	"context"
+	"restaurant-concurrency/internal/domain/model"
+	"sync"
+	"time"
+)
+
+// estados conecta a un worker de la cocina con el registro de estados (línea de tiempo de la UI)
+// Sin registro el worker no reporta nada
+type estados struct {
+	registro *model.RegistroEstados
+	quien    string
+	barra    *model.ContenidoBarra // Reflejo de la barra (solo si la salida es la barra)
+
+	// Estado actual y bloqueo en la salida (observables desde otras goroutines)
+	mu             sync.Mutex
+	actual         model.EstadoGoroutine
+	bloqueadoDesde time.Time     // Cero si no está bloqueado
+	retenido       model.Plato   // Plato en mano mientras espera lugar
+	acumulado      time.Duration // Tiempo bloqueado en bloqueos ya terminados
+}
+
+// UsarRegistro hace que el worker reporte lo que hace en cada momento bajo el nombre indicado
+// Debe llamarse antes de arrancar la goroutine del worker
+func (e *estados) UsarRegistro(registro *model.RegistroEstados, quien string) {
+	e.registro = registro
+	e.quien = quien
+	registro.Reportar(quien, model.EstadoSinDemanda)
+}
+
+// AnotarEnBarra hace que cada plato que deposita el worker quede anotado en el reflejo
+// de la barra (para mostrar qué plato hay en cada lugar)
+// Debe llamarse antes de arrancar la goroutine del worker y solo si su salida es la barra
+func (e *estados) AnotarEnBarra(contenido *model.ContenidoBarra) {
+	e.barra = contenido
+}
+
+// reportar registra el estado actual del worker
+func (e *estados) reportar(estado model.EstadoGoroutine) {
+	e.mu.Lock()
+	e.actual = estado
+	e.mu.Unlock()
+
+	if e.registro != nil {
+		e.registro.Reportar(e.quien, estado)
+	}
+}
+
+// enviar deja el plato en la salida (la barra o la cola de la etapa siguiente)
+// Si está llena registra el bloqueo y SE BLOQUEA hasta que haya lugar
+// Retorna false si el contexto se canceló antes
+func (e *estados) enviar(ctx context.Context, salida chan<- model.Plato, plato model.Plato, reloj *model.Reloj) bool {
+	select {
+	case salida <- plato:
+		e.anotar(plato)
+		return true
+	default:
+	}
+
+	e.reportar(model.EstadoBarraLlena)
+	e.bloquear(plato, reloj.Ahora())
+	defer e.desbloquear(reloj)
+
+	select {
+	case salida <- plato:
+		e.anotar(plato)
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// anotar registra en el reflejo de la barra el plato recién depositado
+func (e *estados) anotar(plato model.Plato) {
+	if e.barra != nil {
+		e.barra.Agregar(plato)
+	}
+}
+
+// estadoActual retorna lo último que reportó el worker
+func (e *estados) estadoActual() model.EstadoGoroutine {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.actual
+}
+
+func (e *estados) bloquear(plato model.Plato, ahora time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.bloqueadoDesde = ahora
+	e.retenido = plato
+}
+
+func (e *estados) desbloquear(reloj *model.Reloj) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.acumulado += reloj.Ahora().Sub(e.bloqueadoDesde)
+	e.bloqueadoDesde = time.Time{}
+	e.retenido = model.Plato{}
+}
+
+// BloqueoSalida retorna si el worker está bloqueado con la salida llena, con qué plato,
+// desde hace cuánto y el total acumulado (sin el nombre del cocinero)
+func (e *estados) BloqueoSalida(ahora time.Time) model.BloqueoProductor {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	bloqueo := model.BloqueoProductor{Acumulado: e.acumulado}
+	if !e.bloqueadoDesde.IsZero() {
+		bloqueo.Bloqueado = true
+		bloqueo.Plato = e.retenido
+		bloqueo.Espera = ahora.Sub(e.bloqueadoDesde)
+		bloqueo.Acumulado += bloqueo.Espera
+	}
+	return bloqueo
+}
@@ -0,0 +1,211 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"restaurant-concurrency/internal/domain/model"
+	"sync/atomic"
+	"time"
+)
+
+// TrabajadorEtapa es un worker de una etapa del pipeline de la cocina
+// La primera etapa PRODUCE platos según la demanda; las siguientes toman el plato
+// de su canal de entrada, trabajan y lo pasan al canal de la etapa siguiente (o a la barra)
+type TrabajadorEtapa struct {
+	id           int
+	etapa        string
+	duracion     time.Duration
+	variacion    time.Duration
+	requeridos   map[string]int // Equipos que ocupa cada plato en la etapa
+	reloj        *model.Reloj   // Reloj del juego (el trabajo se congela en pausa)
+	ingredientes                // Despensa de la que toma cada receta (solo la primera etapa)
+	equipos                     // Equipos compartidos (hornos, freidoras)
+	estados                     // Registro de estados para la línea de tiempo (opcional)
+	numeracion                  // Numerador de platos compartido (solo la primera etapa)
+
+	// Platos dentro del pipeline, compartido por todas las etapas: la primera etapa lo suma
+	// ANTES de mirar la demanda y la última lo resta después de dejar el plato en la barra,
+	// así un plato que pasa de una etapa a otra nunca deja de contarse
+	enPipeline *atomic.Int64
+	ultima     bool // Entrega en la barra
+
+	// Estado observable desde otras goroutines
+	ocupado     atomic.Bool  // Tiene un plato (trabajando o esperando lugar en la salida)
+	procesados  atomic.Int64 // Platos que pasó a la etapa siguiente
+	abandonados atomic.Int64 // Platos que tenía en mano al cancelarse el contexto
+}
+
+func NewTrabajadorEtapa(id int, etapa model.EtapaCocina, reloj *model.Reloj) *TrabajadorEtapa {
+	return &TrabajadorEtapa{
+		id:         id,
+		etapa:      etapa.Nombre,
+		duracion:   etapa.Duracion,
+		variacion:  etapa.Variacion,
+		reloj:      reloj,
+		requeridos: etapa.Equipos,
+	}
+}
+
+// ContarEnPipeline conecta al trabajador con el contador de platos del pipeline
+// Debe llamarse antes de arrancar la goroutine del worker
+func (t *TrabajadorEtapa) ContarEnPipeline(enPipeline *atomic.Int64, ultima bool) {
+	t.enPipeline = enPipeline
+	t.ultima = ultima
+}
+
+// Iniciar ejecuta el loop de la primera etapa (goroutine): empieza platos nuevos
+// mientras haya demanda y los pasa a la etapa siguiente
+func (t *TrabajadorEtapa) Iniciar(
+	ctx context.Context,
+	salida chan<- model.Plato,
+	verificarDemanda func() bool,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		default:
+			t.sumarEnPipeline(1)
+			if !verificarDemanda() {
+				t.sumarEnPipeline(-1)
+				t.reportar(model.EstadoSinDemanda)
+				if !t.reloj.Esperar(ctx, 500*time.Millisecond) {
+					return
+				}
+				continue
+			}
+
+			// Tomar los ingredientes (CONSUMIDOR de la despensa): se bloquea si no alcanzan
+			receta, ok := t.tomarIngredientes(ctx, &t.estados)
+			if !ok {
+				t.sumarEnPipeline(-1) // Todavía no había empezado el plato
+				return
+			}
+
+			t.ocupado.Store(true)
+			plato := model.NewPlato(t.nuevoID(), t.id, t.reloj.Ahora())
+			if receta.Nombre != "" {
+				plato.Nombre = receta.Nombre
+			}
+			if !t.trabajar(ctx, plato, salida) {
+				return
+			}
+		}
+	}
+}
+
+// Procesar ejecuta el loop de una etapa intermedia o final (goroutine)
+// Se bloquea en la entrada si la etapa anterior no le pasa platos y en la salida si la
+// siguiente tiene la cola llena
+func (t *TrabajadorEtapa) Procesar(
+	ctx context.Context,
+	entrada <-chan model.Plato,
+	salida chan<- model.Plato,
+) {
+	for {
+		plato, ok := t.recibir(ctx, entrada)
+		if !ok {
+			return
+		}
+		t.ocupado.Store(true)
+		if !t.trabajar(ctx, plato, salida) {
+			return
+		}
+	}
+}
+
+// recibir toma el próximo plato de la entrada
+// Si la etapa anterior no le pasó ninguno reporta que está sin entrada y SE BLOQUEA
+// Retorna false si el contexto se canceló antes
+func (t *TrabajadorEtapa) recibir(ctx context.Context, entrada <-chan model.Plato) (model.Plato, bool) {
+	select {
+	case plato := <-entrada:
+		return plato, true
+	default:
+	}
+
+	t.reportar(model.EstadoSinEntrada)
+	select {
+	case plato := <-entrada:
+		return plato, true
+	case <-ctx.Done():
+		return model.Plato{}, false
+	}
+}
+
+// trabajar hace la tarea de la etapa sobre el plato y lo pasa a la salida
+// Retorna false si el contexto se canceló (el plato se pierde)
+func (t *TrabajadorEtapa) trabajar(ctx context.Context, plato model.Plato, salida chan<- model.Plato) bool {
+	// Hacer cola por los equipos de la etapa; se liberan al terminar la tarea
+	if !t.tomarEquipos(ctx, &t.estados, t.Nombre(), t.requeridos) {
+		t.abandonar()
+		return false
+	}
+	t.reportar(model.EstadoCocinando)
+	terminado := t.reloj.Esperar(ctx, t.tiempoTrabajo())
+	t.liberarEquipos(t.Nombre(), t.requeridos)
+	if !terminado {
+		t.abandonar()
+		return false
+	}
+
+	// El plato sale de la etapa con la hora actual: al llegar a la barra queda la hora en que salió de cocina
+	plato.Timestamp = t.reloj.Ahora()
+
+	// Si la etapa siguiente tiene la cola llena, SE BLOQUEA aquí (cuello de botella)
+	if !t.enviar(ctx, salida, plato, t.reloj) {
+		t.abandonar()
+		return false
+	}
+	t.ocupado.Store(false)
+	if t.ultima {
+		t.sumarEnPipeline(-1)
+	}
+	t.procesados.Add(1)
+	fmt.Printf("%s %d terminó plato #%d\n", t.etapa, t.id, plato.ID)
+	return true
+}
+
+// Nombre identifica al trabajador en las colas de los equipos (ej. "Hornalla 2")
+func (t *TrabajadorEtapa) Nombre() string {
+	return fmt.Sprintf("%s %d", t.etapa, t.id)
+}
+
+// tiempoTrabajo calcula la duración de la tarea (base + variación aleatoria)
+func (t *TrabajadorEtapa) tiempoTrabajo() time.Duration {
+	if t.variacion <= 0 {
+		return t.duracion
+	}
+	return t.duracion + time.Duration(rand.Int63n(int64(t.variacion)))
+}
+
+// abandonar registra que el plato en mano se pierde por cancelación
+func (t *TrabajadorEtapa) abandonar() {
+	t.ocupado.Store(false)
+	t.abandonados.Add(1)
+	t.sumarEnPipeline(-1)
+}
+
+// sumarEnPipeline actualiza el contador de platos del pipeline (si está conectado)
+func (t *TrabajadorEtapa) sumarEnPipeline(n int64) {
+	if t.enPipeline != nil {
+		t.enPipeline.Add(n)
+	}
+}
+
+// EstaOcupado indica si el trabajador tiene un plato sin pasar a la etapa siguiente
+func (t *TrabajadorEtapa) EstaOcupado() bool {
+	return t.ocupado.Load()
+}
+
+// PlatosProcesados retorna cuántos platos pasó a la etapa siguiente
+func (t *TrabajadorEtapa) PlatosProcesados() int {
+	return int(t.procesados.Load())
+}
+
+// PlatosAbandonados retorna cuántos platos en mano se perdieron por cancelación
+func (t *TrabajadorEtapa) PlatosAbandonados() int {
+	return int(t.abandonados.Load())
+}
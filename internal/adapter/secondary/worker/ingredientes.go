@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"context"
+	"restaurant-concurrency/internal/domain/model"
+	"sync/atomic"
+)
+
+// ingredientes conecta a un worker de la cocina con la despensa
+// Sin despensa el worker cocina sin consumir ingredientes (como antes de tener despensa)
+type ingredientes struct {
+	despensa *model.Despensa
+	recetas  []model.Receta
+
+	esperando atomic.Bool // Bloqueado: no alcanza para ninguna receta
+}
+
+// UsarDespensa hace que cada plato consuma los ingredientes de una de las recetas
+// Debe llamarse antes de arrancar la goroutine del worker
+func (i *ingredientes) UsarDespensa(despensa *model.Despensa, recetas []model.Receta) {
+	i.despensa = despensa
+	i.recetas = recetas
+}
+
+// tomarIngredientes toma los ingredientes de una receta y la retorna (vacía sin despensa)
+// Si no alcanza para ninguna reporta la espera y SE BLOQUEA hasta que el proveedor reponga
+// Retorna false si el contexto se canceló mientras esperaba
+func (i *ingredientes) tomarIngredientes(ctx context.Context, e *estados) (model.Receta, bool) {
+	if i.despensa == nil {
+		return model.Receta{}, true
+	}
+	if receta, ok := i.despensa.IntentarTomar(i.recetas); ok {
+		return receta, true
+	}
+
+	e.reportar(model.EstadoEsperandoRecurso)
+	i.esperando.Store(true)
+	defer i.esperando.Store(false)
+	return i.despensa.Tomar(ctx, i.recetas)
+}
+
+// EsperandoIngredientes indica si el worker está bloqueado por falta de stock
+func (i *ingredientes) EsperandoIngredientes() bool {
+	return i.esperando.Load()
+}
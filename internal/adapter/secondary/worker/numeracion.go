@@ -0,0 +1,24 @@
+package worker
+
+import "restaurant-concurrency/internal/domain/model"
+
+// numeracion da a los platos que empieza un worker un ID del numerador compartido del turno
+// Sin numerador el worker numera sus platos por su cuenta
+type numeracion struct {
+	numerador *model.NumeradorPlatos
+}
+
+// NumerarCon hace que el worker tome los IDs de sus platos del numerador compartido
+// Debe llamarse antes de arrancar la goroutine del worker
+func (n *numeracion) NumerarCon(numerador *model.NumeradorPlatos) {
+	n.numerador = numerador
+}
+
+// nuevoID retorna el ID del próximo plato
+// Solo la goroutine del worker lo llama
+func (n *numeracion) nuevoID() int {
+	if n.numerador == nil {
+		n.numerador = &model.NumeradorPlatos{}
+	}
+	return n.numerador.Siguiente()
+}
@@ -0,0 +1,46 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"restaurant-concurrency/internal/domain/model"
+	"sync/atomic"
+	"time"
+)
+
+// Proveedor es el worker que PRODUCE ingredientes para la despensa
+// Es el productor de la primera capa: despensa -> cocineros -> barra -> meseros
+type Proveedor struct {
+	intervalo time.Duration
+	entrega   map[string]int
+	reloj     *model.Reloj // Reloj del juego (en pausa no hay entregas)
+
+	entregas atomic.Int64 // Reposiciones realizadas (observable desde otras goroutines)
+}
+
+func NewProveedor(intervalo time.Duration, entrega map[string]int, reloj *model.Reloj) *Proveedor {
+	return &Proveedor{
+		intervalo: intervalo,
+		entrega:   entrega,
+		reloj:     reloj,
+	}
+}
+
+// Abastecer ejecuta el loop de reposición (goroutine): cada intervalo lleva la entrega a la despensa
+func (p *Proveedor) Abastecer(ctx context.Context, despensa *model.Despensa) {
+	for {
+		if !p.reloj.Esperar(ctx, p.intervalo) {
+			fmt.Println("Proveedor terminó sus entregas")
+			return
+		}
+
+		entraron := despensa.Reponer(p.entrega)
+		p.entregas.Add(1)
+		fmt.Printf("Proveedor repuso %d ingredientes\n", entraron)
+	}
+}
+
+// Entregas retorna cuántas reposiciones hizo el proveedor
+func (p *Proveedor) Entregas() int {
+	return int(p.entregas.Load())
+}
@@ -0,0 +1,123 @@
+package model
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// PlatoEnBarra es un plato tal como se ve en su lugar de la barra
+type PlatoEnBarra struct {
+	ID         int
+	CocineroID int
+	Tipo       string        // Nombre del plato (la receta)
+	Edad       time.Duration // Desde que salió de cocina
+	Siguiente  bool          // Es el que se llevará la próxima recogida
+}
+
+// ContenidoBarra refleja qué platos hay en el canal de la barra y en qué orden
+// Un canal no deja ver su contenido: cada productor anota el plato después de depositarlo
+// y cada consumidor lo quita después de recogerlo; de paso cuenta producidos, recogidos y
+// desechados con el mismo lock, así que siempre producidos = recogidos + desechados + platos
+// en el reflejo
+// Si el consumidor lo recoge antes de que el productor lo anote, el plato se cuenta como
+// producido y queda como retirado para no agregarlo después (el reflejo nunca muestra un
+// plato que ya no está)
+// Dos platos depositados en el mismo instante pueden quedar anotados en el orden inverso
+// al del canal; al recogerse se quita el plato que realmente salió
+type ContenidoBarra struct {
+	mu         sync.Mutex
+	platos     []Plato
+	retirados  map[int]bool // IDs recogidos antes de anotarse
+	producidos int
+	recogidos  int
+	desechados int // Se tiraron desde la barra al cerrar
+}
+
+func NewContenidoBarra() *ContenidoBarra {
+	return &ContenidoBarra{retirados: make(map[int]bool)}
+}
+
+// Agregar anota un plato que se acaba de depositar en la barra
+func (c *ContenidoBarra) Agregar(plato Plato) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.retirados[plato.ID] {
+		delete(c.retirados, plato.ID) // Ya se contó al recogerlo
+		return
+	}
+	c.producidos++
+	c.platos = append(c.platos, plato)
+}
+
+// Quitar borra un plato que se acaba de recoger de la barra
+func (c *ContenidoBarra) Quitar(plato Plato) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.recogidos++
+	c.sacar(plato)
+}
+
+// Desechar borra un plato que se sacó de la barra para tirarlo
+func (c *ContenidoBarra) Desechar(plato Plato) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.desechados++
+	c.sacar(plato)
+}
+
+// sacar borra el plato del reflejo; si todavía no estaba anotado lo cuenta como producido
+// y lo marca como retirado
+// DEBE ser llamado mientras se tiene el lock de mu
+func (c *ContenidoBarra) sacar(plato Plato) {
+	for i := range c.platos {
+		if c.platos[i].ID == plato.ID {
+			c.platos = slices.Delete(c.platos, i, i+1)
+			return
+		}
+	}
+	c.producidos++
+	c.retirados[plato.ID] = true
+}
+
+// Contabilidad retorna cuántos platos llegaron a la barra, cuántos quedan, cuántos se
+// recogieron y cuántos se tiraron desde la barra (entregas y platos en mano las lleva el servicio)
+func (c *ContenidoBarra) Contabilidad() ContabilidadPlatos {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return ContabilidadPlatos{
+		Producidos:     c.producidos,
+		EnBarra:        len(c.platos),
+		Recogidos:      c.recogidos,
+		Desperdiciados: c.desechados,
+	}
+}
+
+// Snapshot retorna los platos en orden de llegada con su edad; el primero es el próximo a salir
+func (c *ContenidoBarra) Snapshot(ahora time.Time) []PlatoEnBarra {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	platos := make([]PlatoEnBarra, 0, len(c.platos))
+	for i, plato := range c.platos {
+		platos = append(platos, PlatoEnBarra{
+			ID:         plato.ID,
+			CocineroID: plato.CocineroID,
+			Tipo:       plato.Nombre,
+			Edad:       plato.Edad(ahora),
+			Siguiente:  i == 0,
+		})
+	}
+	return platos
+}
+
+// Frescura retorna 1 mientras el plato está recién hecho y baja hasta 0 al llegar a FrescuraMaxima
+func (p PlatoEnBarra) Frescura() float64 {
+	if p.Edad <= FrescuraIdeal {
+		return 1
+	}
+	return limitar(1 - float64(p.Edad-FrescuraIdeal)/float64(FrescuraMaxima-FrescuraIdeal))
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// operacionBarra es un paso de las pruebas del reflejo de la barra
+type operacionBarra struct {
+	tipo  string // "agregar", "quitar" o "desechar"
+	plato int
+}
+
+func TestContenidoBarraReflejaElCanal(t *testing.T) {
+	casos := []struct {
+		nombre      string
+		operaciones []operacionBarra
+		enBarra     []int // IDs en el reflejo, en orden
+		cuenta      ContabilidadPlatos
+	}{
+		{
+			nombre: "vacía",
+			cuenta: ContabilidadPlatos{},
+		},
+		{
+			nombre:      "en orden de llegada",
+			operaciones: []operacionBarra{{"agregar", 1}, {"agregar", 2}, {"agregar", 3}},
+			enBarra:     []int{1, 2, 3},
+			cuenta:      ContabilidadPlatos{Producidos: 3, EnBarra: 3},
+		},
+		{
+			nombre:      "quitar el que salió aunque no sea el primero",
+			operaciones: []operacionBarra{{"agregar", 1}, {"agregar", 2}, {"quitar", 2}},
+			enBarra:     []int{1},
+			cuenta:      ContabilidadPlatos{Producidos: 2, EnBarra: 1, Recogidos: 1},
+		},
+		{
+			nombre:      "recogido antes de anotarse",
+			operaciones: []operacionBarra{{"quitar", 1}, {"agregar", 1}, {"agregar", 2}},
+			enBarra:     []int{2},
+			cuenta:      ContabilidadPlatos{Producidos: 2, EnBarra: 1, Recogidos: 1},
+		},
+		{
+			nombre:      "desechados al cerrar",
+			operaciones: []operacionBarra{{"agregar", 1}, {"agregar", 2}, {"quitar", 1}, {"desechar", 2}},
+			cuenta:      ContabilidadPlatos{Producidos: 2, Recogidos: 1, Desperdiciados: 1},
+		},
+		{
+			nombre:      "desechado antes de anotarse",
+			operaciones: []operacionBarra{{"desechar", 1}, {"agregar", 1}},
+			cuenta:      ContabilidadPlatos{Producidos: 1, Desperdiciados: 1},
+		},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			ahora := time.Now()
+			contenido := NewContenidoBarra()
+			for _, op := range caso.operaciones {
+				plato := NewPlato(op.plato, 1, ahora)
+				switch op.tipo {
+				case "agregar":
+					contenido.Agregar(plato)
+				case "quitar":
+					contenido.Quitar(plato)
+				case "desechar":
+					contenido.Desechar(plato)
+				}
+			}
+
+			var enBarra []int
+			for i, plato := range contenido.Snapshot(ahora) {
+				enBarra = append(enBarra, plato.ID)
+				if plato.Siguiente != (i == 0) {
+					t.Fatalf("lugar %d marcado como siguiente = %v", i, plato.Siguiente)
+				}
+			}
+			if !slices.Equal(enBarra, caso.enBarra) {
+				t.Fatalf("en la barra %v, se esperaba %v", enBarra, caso.enBarra)
+			}
+			if cuenta := contenido.Contabilidad(); cuenta != caso.cuenta {
+				t.Fatalf("contabilidad %+v, se esperaba %+v", cuenta, caso.cuenta)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package model
+
+// ReporteCierre resume lo que quedó sin servir al cerrar el restaurante
+type ReporteCierre struct {
+	EnBarra            int  // Platos que quedaron en la barra
+	EnCocina           int  // Platos empezados que los cocineros no terminaron
+	EnMano             int  // Platos recogidos que el mesero no llegó a entregar
+	ClientesSinAtender int  // Clientes que seguían esperando al cerrar
+	PorPlazo           bool // El cierre se cortó por el plazo, no porque terminó el servicio
+}
+
+// Desperdiciados retorna el total de platos que se tiraron
+func (r ReporteCierre) Desperdiciados() int {
+	return r.EnBarra + r.EnCocina + r.EnMano
+}
@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+// EtapaCocina configura una etapa del pipeline de la cocina (ej. preparación -> hornalla -> emplatado)
+// Las etapas se conectan con canales acotados: si una etapa es lenta, su cola se llena y
+// la etapa anterior se bloquea (cuello de botella)
+type EtapaCocina struct {
+	Nombre       string
+	Trabajadores int            // Goroutines que trabajan en la etapa
+	Duracion     time.Duration  // Tiempo mínimo por plato
+	Variacion    time.Duration  // Variación aleatoria sobre la duración
+	Cola         int            // Capacidad del canal de entrada (la primera etapa arranca según la demanda)
+	Equipos      map[string]int // Equipos que ocupa cada plato en la etapa (ej. la hornalla pide un horno)
+}
+
+// EtapaSnapshot es el estado observable de una etapa del pipeline
+type EtapaSnapshot struct {
+	Nombre        string
+	Trabajadores  int
+	Ocupados      int // Trabajadores con un plato (trabajando o esperando lugar en la etapa siguiente)
+	EnCola        int // Platos esperando en el canal de entrada
+	CapacidadCola int // 0 en la primera etapa
+	Procesados    int
+}
+
+// ColaLlena indica si la etapa es un cuello de botella: la anterior ya no puede pasarle platos
+func (e EtapaSnapshot) ColaLlena() bool {
+	return e.CapacidadCola > 0 && e.EnCola >= e.CapacidadCola
+}
+
+// BloqueoProductor indica si un cocinero está bloqueado con un plato en mano porque la barra
+// está llena, desde hace cuánto y cuánto tiempo acumula bloqueado en el turno
+type BloqueoProductor struct {
+	Cocinero  string
+	Bloqueado bool
+	Plato     Plato         // Plato en mano mientras está bloqueado
+	Espera    time.Duration // Desde que se bloqueó (0 si no está bloqueado)
+	Acumulado time.Duration // Total del turno, incluida la espera actual
+}
+
+// CocineroSnapshot es el estado observable de un cocinero (para dibujarlo, como MesaSnapshot)
+type CocineroSnapshot struct {
+	ID            int
+	Nombre        string
+	Estado        EstadoGoroutine
+	Plato         string        // Receta en el fuego ("" si no está cocinando o sin despensa)
+	Transcurrido  time.Duration // Tiempo de cocción del plato actual
+	TiempoCoccion time.Duration // Duración total del plato actual
+	Producidos    int           // Platos que dejó en la barra en el turno
+	Bloqueo       BloqueoProductor
+}
+
+// Progreso retorna la fracción cocinada del plato actual (0 si no está cocinando)
+func (c CocineroSnapshot) Progreso() float64 {
+	if c.TiempoCoccion <= 0 {
+		return 0
+	}
+	return min(1, float64(c.Transcurrido)/float64(c.TiempoCoccion))
+}
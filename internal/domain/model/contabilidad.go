@@ -0,0 +1,21 @@
+package model
+
+// ContabilidadPlatos resume qué pasó con los platos del turno
+// Cada plato que llega a la barra está en un solo lugar: sigue en la barra, está en mano,
+// se entregó o se tiró (Producidos = EnBarra + EnMano + Entregados + Desperdiciados)
+// Los platos que no salieron de cocina no cuentan: van aparte en ReporteCierre.EnCocina
+type ContabilidadPlatos struct {
+	Producidos     int // Llegaron a la barra
+	EnBarra        int // Siguen en la barra (buffer)
+	Recogidos      int // Los meseros los sacaron de la barra
+	Entregados     int // Llegaron a una mesa
+	EnMano         int // Recogidos que todavía no se entregaron
+	Desperdiciados int // Se tiraron desde la barra o desde la mano (al cerrar)
+}
+
+// Consistente verifica las invariantes de la contabilidad
+func (c ContabilidadPlatos) Consistente() bool {
+	return c.Producidos == c.EnBarra+c.EnMano+c.Entregados+c.Desperdiciados &&
+		c.Recogidos >= c.Entregados+c.EnMano &&
+		c.EnBarra >= 0 && c.EnMano >= 0 && c.Entregados >= 0 && c.Desperdiciados >= 0
+}
@@ -0,0 +1,26 @@
+package model
+
+import "testing"
+
+func TestContabilidadConsistente(t *testing.T) {
+	casos := []struct {
+		nombre      string
+		cuenta      ContabilidadPlatos
+		consistente bool
+	}{
+		{"turno vacío", ContabilidadPlatos{}, true},
+		{"en curso", ContabilidadPlatos{Producidos: 10, EnBarra: 3, Recogidos: 7, Entregados: 5, EnMano: 2}, true},
+		{"después de cerrar", ContabilidadPlatos{Producidos: 10, Recogidos: 7, Entregados: 5, Desperdiciados: 5}, true},
+		{"plato perdido", ContabilidadPlatos{Producidos: 10, EnBarra: 3, Recogidos: 6, Entregados: 5}, false},
+		{"desperdicio contado dos veces", ContabilidadPlatos{Producidos: 10, EnBarra: 3, Recogidos: 7, Entregados: 5, EnMano: 2, Desperdiciados: 5}, false},
+		{"entregado sin recoger", ContabilidadPlatos{Producidos: 4, Recogidos: 3, Entregados: 4}, false},
+		{"en mano negativo", ContabilidadPlatos{Producidos: 4, EnBarra: 5, Recogidos: 0, EnMano: -1}, false},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			if got := caso.cuenta.Consistente(); got != caso.consistente {
+				t.Fatalf("Consistente() = %v para %+v", got, caso.cuenta)
+			}
+		})
+	}
+}
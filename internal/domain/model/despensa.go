@@ -0,0 +1,169 @@
+package model
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Ingrediente es un insumo de la despensa con su stock máximo
+type Ingrediente struct {
+	Nombre    string
+	Capacidad int
+}
+
+// Receta indica cuánto de cada ingrediente consume un plato y qué equipos ocupa al cocinarse
+type Receta struct {
+	Nombre       string
+	Ingredientes map[string]int
+	Equipos      map[string]int // Equipo -> unidades (ej. un horno)
+}
+
+// ConfigDespensa configura la despensa de un nivel y la reposición del proveedor
+type ConfigDespensa struct {
+	Ingredientes        []Ingrediente
+	Recetas             []Receta
+	IntervaloReposicion time.Duration  // Cada cuánto llega el proveedor
+	Reposicion          map[string]int // Lo que trae el proveedor en cada entrega
+}
+
+// Activa indica si el nivel usa despensa (sin recetas, los cocineros no consumen ingredientes)
+func (c ConfigDespensa) Activa() bool {
+	return len(c.Recetas) > 0
+}
+
+// IngredienteSnapshot es el stock observable de un ingrediente
+type IngredienteSnapshot struct {
+	Nombre    string
+	Stock     int
+	Capacidad int
+}
+
+// Despensa es el BUFFER de ingredientes entre el proveedor (productor) y los cocineros (consumidores)
+// Cada ingrediente tiene un stock acotado; los cocineros toman los ingredientes de una receta
+// y se bloquean si no alcanza para ninguna hasta que llega una reposición
+type Despensa struct {
+	mu        sync.Mutex
+	stock     map[string]int
+	capacidad map[string]int
+	repuesto  chan struct{} // Se cierra en cada reposición para despertar a quienes esperan
+}
+
+// NewDespensa crea la despensa con todos los ingredientes al máximo
+func NewDespensa(ingredientes []Ingrediente) *Despensa {
+	d := &Despensa{
+		stock:     make(map[string]int, len(ingredientes)),
+		capacidad: make(map[string]int, len(ingredientes)),
+		repuesto:  make(chan struct{}),
+	}
+	for _, ingrediente := range ingredientes {
+		d.stock[ingrediente.Nombre] = ingrediente.Capacidad
+		d.capacidad[ingrediente.Nombre] = ingrediente.Capacidad
+	}
+	return d
+}
+
+// Tomar descuenta los ingredientes de la primera receta que alcance, empezando por una al azar
+// (si se acaba un ingrediente el cocinero cambia de receta)
+// Si no alcanza para ninguna SE BLOQUEA hasta la próxima reposición
+// Retorna false si el contexto se canceló antes
+func (d *Despensa) Tomar(ctx context.Context, recetas []Receta) (Receta, bool) {
+	for {
+		d.mu.Lock()
+		receta, ok := d.tomarDisponible(recetas)
+		repuesto := d.repuesto
+		d.mu.Unlock()
+		if ok {
+			return receta, true
+		}
+
+		select {
+		case <-repuesto:
+		case <-ctx.Done():
+			return Receta{}, false
+		}
+	}
+}
+
+// IntentarTomar es Tomar sin bloquear: retorna false si no alcanza para ninguna receta
+func (d *Despensa) IntentarTomar(recetas []Receta) (Receta, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.tomarDisponible(recetas)
+}
+
+// tomarDisponible descuenta la primera receta que alcance, empezando por una al azar
+// DEBE ser llamado mientras se tiene el lock de mu
+func (d *Despensa) tomarDisponible(recetas []Receta) (Receta, bool) {
+	if len(recetas) == 0 {
+		return Receta{}, false
+	}
+	inicio := rand.Intn(len(recetas))
+	for i := range recetas {
+		receta := recetas[(inicio+i)%len(recetas)]
+		if d.alcanza(receta) {
+			for nombre, cantidad := range receta.Ingredientes {
+				d.stock[nombre] -= cantidad
+			}
+			return receta, true
+		}
+	}
+	return Receta{}, false
+}
+
+// alcanza indica si hay stock para la receta
+// DEBE ser llamado mientras se tiene el lock de mu
+func (d *Despensa) alcanza(receta Receta) bool {
+	for nombre, cantidad := range receta.Ingredientes {
+		if d.stock[nombre] < cantidad {
+			return false
+		}
+	}
+	return true
+}
+
+// Reponer suma lo que trae el proveedor sin pasar la capacidad de cada ingrediente
+// y despierta a los cocineros que esperaban; retorna cuántas unidades entraron
+func (d *Despensa) Reponer(cantidades map[string]int) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entraron := 0
+	for nombre, cantidad := range cantidades {
+		capacidad, ok := d.capacidad[nombre]
+		if !ok {
+			continue
+		}
+		nuevo := min(capacidad, d.stock[nombre]+cantidad)
+		entraron += nuevo - d.stock[nombre]
+		d.stock[nombre] = nuevo
+	}
+
+	close(d.repuesto)
+	d.repuesto = make(chan struct{})
+	return entraron
+}
+
+// Snapshot retorna el stock de cada ingrediente ordenado por nombre
+func (d *Despensa) Snapshot() []IngredienteSnapshot {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ingredientes := make([]IngredienteSnapshot, 0, len(d.stock))
+	for nombre, stock := range d.stock {
+		ingredientes = append(ingredientes, IngredienteSnapshot{
+			Nombre:    nombre,
+			Stock:     stock,
+			Capacidad: d.capacidad[nombre],
+		})
+	}
+	slices.SortFunc(ingredientes, compararIngredientes)
+	return ingredientes
+}
+
+func compararIngredientes(a, b IngredienteSnapshot) int {
+	return strings.Compare(a.Nombre, b.Nombre)
+}
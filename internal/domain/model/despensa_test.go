@@ -0,0 +1,107 @@
+package model
+
+import (
+	"context"
+	"maps"
+	"testing"
+	"time"
+)
+
+// stockDespensa retorna el stock de cada ingrediente
+func stockDespensa(d *Despensa) map[string]int {
+	stock := make(map[string]int)
+	for _, ingrediente := range d.Snapshot() {
+		stock[ingrediente.Nombre] = ingrediente.Stock
+	}
+	return stock
+}
+
+func TestDespensaIntentarTomar(t *testing.T) {
+	ingredientes := []Ingrediente{{Nombre: "Carne", Capacidad: 2}, {Nombre: "Pan", Capacidad: 1}}
+	hamburguesa := Receta{Nombre: "Hamburguesa", Ingredientes: map[string]int{"Carne": 1, "Pan": 1}}
+	bife := Receta{Nombre: "Bife", Ingredientes: map[string]int{"Carne": 2}}
+	sandwich := Receta{Nombre: "Sandwich", Ingredientes: map[string]int{"Pan": 2}}
+
+	casos := []struct {
+		nombre  string
+		recetas []Receta
+		receta  string // Receta tomada ("" = no alcanza)
+		stock   map[string]int
+	}{
+		{"sin recetas", nil, "", map[string]int{"Carne": 2, "Pan": 1}},
+		{"alcanza", []Receta{hamburguesa}, "Hamburguesa", map[string]int{"Carne": 1, "Pan": 0}},
+		{"no alcanza", []Receta{sandwich}, "", map[string]int{"Carne": 2, "Pan": 1}},
+		{"cambia a la que alcanza", []Receta{sandwich, bife}, "Bife", map[string]int{"Carne": 0, "Pan": 1}},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			d := NewDespensa(ingredientes)
+			receta, ok := d.IntentarTomar(caso.recetas)
+			if ok != (caso.receta != "") || receta.Nombre != caso.receta {
+				t.Fatalf("tomó %q (ok = %v), se esperaba %q", receta.Nombre, ok, caso.receta)
+			}
+			if stock := stockDespensa(d); !maps.Equal(stock, caso.stock) {
+				t.Fatalf("stock %v, se esperaba %v", stock, caso.stock)
+			}
+		})
+	}
+}
+
+func TestDespensaReponer(t *testing.T) {
+	casos := []struct {
+		nombre     string
+		cantidades map[string]int
+		entraron   int
+		stock      map[string]int
+	}{
+		{"nada", nil, 0, map[string]int{"Carne": 0, "Pan": 1}},
+		{"hasta la capacidad", map[string]int{"Carne": 2}, 2, map[string]int{"Carne": 2, "Pan": 1}},
+		{"sin pasar la capacidad", map[string]int{"Carne": 5, "Pan": 5}, 4, map[string]int{"Carne": 3, "Pan": 2}},
+		{"ingrediente desconocido", map[string]int{"Queso": 4}, 0, map[string]int{"Carne": 0, "Pan": 1}},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			// Empieza con la carne agotada y un pan menos
+			d := NewDespensa([]Ingrediente{{Nombre: "Carne", Capacidad: 3}, {Nombre: "Pan", Capacidad: 2}})
+			if _, ok := d.IntentarTomar([]Receta{{Ingredientes: map[string]int{"Carne": 3, "Pan": 1}}}); !ok {
+				t.Fatal("no se pudo vaciar la despensa")
+			}
+			if entraron := d.Reponer(caso.cantidades); entraron != caso.entraron {
+				t.Fatalf("entraron %d, se esperaban %d", entraron, caso.entraron)
+			}
+			if stock := stockDespensa(d); !maps.Equal(stock, caso.stock) {
+				t.Fatalf("stock %v, se esperaba %v", stock, caso.stock)
+			}
+		})
+	}
+}
+
+func TestDespensaTomarEsperaLaReposicion(t *testing.T) {
+	d := NewDespensa([]Ingrediente{{Nombre: "Carne", Capacidad: 1}})
+	bife := []Receta{{Nombre: "Bife", Ingredientes: map[string]int{"Carne": 1}}}
+	if _, ok := d.Tomar(context.Background(), bife); !ok {
+		t.Fatal("no se tomó el stock inicial")
+	}
+
+	tomada := make(chan bool)
+	go func() {
+		_, ok := d.Tomar(context.Background(), bife)
+		tomada <- ok
+	}()
+	select {
+	case <-tomada:
+		t.Fatal("se tomó carne de una despensa vacía")
+	case <-time.After(20 * time.Millisecond):
+	}
+	d.Reponer(map[string]int{"Carne": 1})
+	if !<-tomada {
+		t.Fatal("la reposición no despertó al cocinero")
+	}
+
+	// Cancelado, vuelve sin tomar nada
+	ctx, cancelar := context.WithCancel(context.Background())
+	cancelar()
+	if _, ok := d.Tomar(ctx, bife); ok {
+		t.Fatal("se tomó carne de una despensa vacía con el contexto cancelado")
+	}
+}
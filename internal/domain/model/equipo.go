@@ -0,0 +1,213 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// EquipoCocina configura un equipo compartido de la cocina (ej. 2 hornos, 1 freidora)
+type EquipoCocina struct {
+	Nombre    string
+	Capacidad int // Unidades disponibles (peso máximo del semáforo)
+}
+
+// EquipoSnapshot es el estado observable de un equipo: quién lo usa y quién espera
+type EquipoSnapshot struct {
+	Nombre    string
+	Capacidad int
+	EnUso     int
+	Usando    []string // "Cocinero 1" o "Cocinero 1 x2" si usa más de una unidad
+	Esperando []string
+}
+
+// Equipo es un recurso compartido de la cocina protegido por un semáforo ponderado
+// Cada plato pide una o más unidades; si no hay lugar el cocinero hace cola
+type Equipo struct {
+	nombre    string
+	capacidad int
+	sem       *semaphore.Weighted
+
+	// Solo para observar: el semáforo no expone quién lo tiene
+	mu        sync.Mutex
+	usando    map[string]int
+	esperando map[string]int
+	orden     []string // Orden de llegada de quienes esperan
+}
+
+func NewEquipo(config EquipoCocina) *Equipo {
+	return &Equipo{
+		nombre:    config.Nombre,
+		capacidad: config.Capacidad,
+		sem:       semaphore.NewWeighted(int64(config.Capacidad)),
+		usando:    make(map[string]int),
+		esperando: make(map[string]int),
+	}
+}
+
+// Adquirir toma unidades del equipo; SE BLOQUEA en la cola hasta que haya lugar
+// Retorna false si el contexto se canceló antes
+func (e *Equipo) Adquirir(ctx context.Context, quien string, unidades int) bool {
+	e.mu.Lock()
+	e.esperando[quien] = unidades
+	e.orden = append(e.orden, quien)
+	e.mu.Unlock()
+
+	err := e.sem.Acquire(ctx, int64(unidades))
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.esperando, quien)
+	if i := slices.Index(e.orden, quien); i >= 0 {
+		e.orden = slices.Delete(e.orden, i, i+1)
+	}
+	if err != nil {
+		return false
+	}
+	e.usando[quien] += unidades
+	return true
+}
+
+// IntentarAdquirir toma unidades solo si hay lugar ahora, sin hacer cola
+// Quien reintenta así puede no conseguir nunca el equipo si otro lo vuelve a tomar enseguida
+func (e *Equipo) IntentarAdquirir(quien string, unidades int) bool {
+	if !e.sem.TryAcquire(int64(unidades)) {
+		return false
+	}
+	e.mu.Lock()
+	e.usando[quien] += unidades
+	e.mu.Unlock()
+	return true
+}
+
+// Titulares retorna quiénes tienen tomado el equipo
+func (e *Equipo) Titulares() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	titulares := make([]string, 0, len(e.usando))
+	for quien := range e.usando {
+		titulares = append(titulares, quien)
+	}
+	slices.Sort(titulares)
+	return titulares
+}
+
+// Liberar devuelve las unidades al equipo y despierta al siguiente en la cola
+func (e *Equipo) Liberar(quien string, unidades int) {
+	e.mu.Lock()
+	e.usando[quien] -= unidades
+	if e.usando[quien] <= 0 {
+		delete(e.usando, quien)
+	}
+	e.mu.Unlock()
+
+	e.sem.Release(int64(unidades))
+}
+
+// Snapshot retorna quién usa el equipo y quién espera (en orden de llegada)
+func (e *Equipo) Snapshot() EquipoSnapshot {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	snapshot := EquipoSnapshot{Nombre: e.nombre, Capacidad: e.capacidad}
+	for quien, unidades := range e.usando {
+		snapshot.EnUso += unidades
+		snapshot.Usando = append(snapshot.Usando, describirUso(quien, unidades))
+	}
+	slices.Sort(snapshot.Usando)
+	for _, quien := range e.orden {
+		snapshot.Esperando = append(snapshot.Esperando, describirUso(quien, e.esperando[quien]))
+	}
+	return snapshot
+}
+
+func describirUso(quien string, unidades int) string {
+	if unidades > 1 {
+		return fmt.Sprintf("%s x%d", quien, unidades)
+	}
+	return quien
+}
+
+// Equipamiento es el conjunto de equipos compartidos de la cocina
+type Equipamiento struct {
+	equipos map[string]*Equipo
+	nombres []string // Orden global de adquisición (alfabético)
+}
+
+func NewEquipamiento(configs []EquipoCocina) *Equipamiento {
+	eq := &Equipamiento{equipos: make(map[string]*Equipo, len(configs))}
+	for _, config := range configs {
+		eq.equipos[config.Nombre] = NewEquipo(config)
+		eq.nombres = append(eq.nombres, config.Nombre)
+	}
+	slices.Sort(eq.nombres)
+	return eq
+}
+
+// Equipo retorna un equipo por nombre (nil si no existe)
+func (eq *Equipamiento) Equipo(nombre string) *Equipo {
+	return eq.equipos[nombre]
+}
+
+// Adquirir toma todos los equipos que pide un plato, SIEMPRE en el mismo orden (alfabético)
+// Con un orden global dos cocineros nunca se quedan esperando cada uno el equipo del otro
+// Si el contexto se cancela a mitad de camino, libera lo que ya había tomado
+func (eq *Equipamiento) Adquirir(ctx context.Context, quien string, requeridos map[string]int) bool {
+	tomados := make([]string, 0, len(requeridos))
+	for _, nombre := range eq.nombres {
+		unidades, ok := requeridos[nombre]
+		if !ok || unidades <= 0 {
+			continue
+		}
+		if !eq.equipos[nombre].Adquirir(ctx, quien, unidades) {
+			for _, tomado := range tomados {
+				eq.equipos[tomado].Liberar(quien, requeridos[tomado])
+			}
+			return false
+		}
+		tomados = append(tomados, nombre)
+	}
+	return true
+}
+
+// IntentarAdquirir toma todos los equipos que pide un plato solo si están libres, sin hacer cola
+// Si alguno está ocupado no se queda con ninguno (mismo orden que Adquirir)
+func (eq *Equipamiento) IntentarAdquirir(quien string, requeridos map[string]int) bool {
+	tomados := make([]string, 0, len(requeridos))
+	for _, nombre := range eq.nombres {
+		unidades, ok := requeridos[nombre]
+		if !ok || unidades <= 0 {
+			continue
+		}
+		if !eq.equipos[nombre].IntentarAdquirir(quien, unidades) {
+			for _, tomado := range tomados {
+				eq.equipos[tomado].Liberar(quien, requeridos[tomado])
+			}
+			return false
+		}
+		tomados = append(tomados, nombre)
+	}
+	return true
+}
+
+// Liberar devuelve todos los equipos que pidió el plato
+func (eq *Equipamiento) Liberar(quien string, requeridos map[string]int) {
+	for nombre, unidades := range requeridos {
+		if equipo, ok := eq.equipos[nombre]; ok && unidades > 0 {
+			equipo.Liberar(quien, unidades)
+		}
+	}
+}
+
+// Snapshot retorna el estado de cada equipo en orden alfabético
+func (eq *Equipamiento) Snapshot() []EquipoSnapshot {
+	equipos := make([]EquipoSnapshot, 0, len(eq.nombres))
+	for _, nombre := range eq.nombres {
+		equipos = append(equipos, eq.equipos[nombre].Snapshot())
+	}
+	return equipos
+}
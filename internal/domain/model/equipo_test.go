@@ -0,0 +1,105 @@
+package model
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+// equiposDePrueba son una freidora y dos hornos
+var equiposDePrueba = []EquipoCocina{{Nombre: "Horno", Capacidad: 2}, {Nombre: "Freidora", Capacidad: 1}}
+
+// enUso retorna las unidades en uso de cada equipo
+func enUso(eq *Equipamiento) map[string]int {
+	uso := make(map[string]int)
+	for _, equipo := range eq.Snapshot() {
+		uso[equipo.Nombre] = equipo.EnUso
+	}
+	return uso
+}
+
+func TestEquipamientoIntentarAdquirirEsTodoONada(t *testing.T) {
+	casos := []struct {
+		nombre   string
+		ocupados map[string]int // Lo que ya tiene otro cocinero
+		pedido   map[string]int
+		ok       bool
+		freidora int // En uso al terminar
+		hornos   int
+	}{
+		{"todo libre", nil, map[string]int{"Horno": 2, "Freidora": 1}, true, 1, 2},
+		{"horno ocupado", map[string]int{"Horno": 2}, map[string]int{"Freidora": 1, "Horno": 1}, false, 0, 2},
+		{"freidora ocupada", map[string]int{"Freidora": 1}, map[string]int{"Freidora": 1, "Horno": 1}, false, 1, 0},
+		{"alcanza con lo que queda", map[string]int{"Horno": 1}, map[string]int{"Horno": 1}, true, 0, 2},
+		{"sin unidades no toma nada", nil, map[string]int{"Horno": 0}, true, 0, 0},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			eq := NewEquipamiento(equiposDePrueba)
+			if !eq.IntentarAdquirir("Otro", caso.ocupados) {
+				t.Fatal("no se pudo ocupar el equipamiento")
+			}
+			if ok := eq.IntentarAdquirir("Cocinero", caso.pedido); ok != caso.ok {
+				t.Fatalf("IntentarAdquirir = %v, se esperaba %v", ok, caso.ok)
+			}
+			if uso := enUso(eq); uso["Freidora"] != caso.freidora || uso["Horno"] != caso.hornos {
+				t.Fatalf("en uso %v, se esperaban %d freidora y %d hornos", uso, caso.freidora, caso.hornos)
+			}
+		})
+	}
+}
+
+func TestEquipamientoAdquiereEnOrdenAlfabetico(t *testing.T) {
+	pedido := map[string]int{"Horno": 2, "Freidora": 1}
+	casos := []struct {
+		nombre   string
+		ocupado  string // Equipo que tiene otro cocinero
+		tomado   string // Equipo que el cocinero ya tomó mientras espera ("" = ninguno)
+		esperado string // Equipo en cuya cola queda
+	}{
+		// La freidora va antes que el horno: la toma y queda esperando los hornos
+		{"horno ocupado", "Horno", "Freidora", "Horno"},
+		// Esperando la freidora todavía no tocó los hornos
+		{"freidora ocupada", "Freidora", "", "Freidora"},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			eq := NewEquipamiento(equiposDePrueba)
+			eq.Equipo(caso.ocupado).Adquirir(context.Background(), "Otro", 1)
+
+			ctx, cancelar := context.WithCancel(context.Background())
+			adquirido := make(chan bool)
+			go func() {
+				adquirido <- eq.Adquirir(ctx, "Cocinero", pedido)
+			}()
+			limite := time.Now().Add(time.Second)
+			for !slices.ContainsFunc(eq.Equipo(caso.esperado).Snapshot().Esperando, esCocinero) {
+				if time.Now().After(limite) {
+					t.Fatalf("el cocinero no quedó en la cola del %s: %+v", caso.esperado, eq.Snapshot())
+				}
+				time.Sleep(time.Millisecond)
+			}
+			for _, equipo := range eq.Snapshot() {
+				tiene := slices.ContainsFunc(equipo.Usando, esCocinero)
+				if tiene != (equipo.Nombre == caso.tomado) {
+					t.Fatalf("mientras espera el %s, en %s usa %v", caso.esperado, equipo.Nombre, equipo.Usando)
+				}
+			}
+
+			// Al cancelarse suelta lo que había tomado
+			cancelar()
+			if <-adquirido {
+				t.Fatal("se adquirió el equipamiento con un equipo ocupado")
+			}
+			if uso := enUso(eq); uso[caso.ocupado] != 1 || uso["Horno"]+uso["Freidora"] != 1 {
+				t.Fatalf("después de cancelar quedó en uso %v", uso)
+			}
+		})
+	}
+}
+
+// esCocinero indica si la descripción de uso es del cocinero de la prueba
+func esCocinero(uso string) bool {
+	return uso == "Cocinero" || uso == "Cocinero x2"
+}
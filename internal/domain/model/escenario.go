@@ -0,0 +1,166 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// TipoEscenario es un escenario didáctico que provoca un problema clásico de concurrencia en la cocina
+type TipoEscenario string
+
+const (
+	EscenarioNinguno      TipoEscenario = ""
+	EscenarioInterbloqueo TipoEscenario = "interbloqueo" // Dos cocineros toman dos utensilios en orden opuesto
+	EscenarioInanicion    TipoEscenario = "inanicion"    // Un cocinero acapara el horno y otro nunca lo consigue
+)
+
+// Escenarios son los escenarios seleccionables, en el orden del menú
+var Escenarios = []TipoEscenario{EscenarioNinguno, EscenarioInterbloqueo, EscenarioInanicion}
+
+func (t TipoEscenario) String() string {
+	switch t {
+	case EscenarioInterbloqueo:
+		return "Interbloqueo (deadlock)"
+	case EscenarioInanicion:
+		return "Inanicion (starvation)"
+	}
+	return "Ninguno"
+}
+
+// Escenario es el escenario elegido y si se aplica la corrección
+// Interbloqueo: la corrección es tomar los utensilios en un orden global
+// Inanición: la corrección es hacer cola (semáforo FIFO) en lugar de reintentar
+type Escenario struct {
+	Tipo      TipoEscenario
+	Corregido bool
+}
+
+// Activo indica si hay un escenario que reemplaza a la cocina del nivel
+func (e Escenario) Activo() bool {
+	return e.Tipo != EscenarioNinguno
+}
+
+// CocineroEscenario configura a un cocinero del escenario
+type CocineroEscenario struct {
+	Nombre      string
+	Recursos    []string      // Utensilios en el orden en que los toma
+	Preparacion time.Duration // Tiempo con el primer utensilio antes de pedir el siguiente
+	Trabajo     time.Duration // Tiempo con todos los utensilios
+	Pausa       time.Duration // Descanso entre platos (0 = vuelve a tomar enseguida)
+	Reintenta   bool          // Intenta sin hacer cola y reintenta (puede no conseguirlo nunca)
+}
+
+// Equipos retorna los utensilios compartidos del escenario (uno de cada uno)
+func (e Escenario) Equipos() []EquipoCocina {
+	switch e.Tipo {
+	case EscenarioInterbloqueo:
+		return []EquipoCocina{{Nombre: "Cuchillo", Capacidad: 1}, {Nombre: "Tabla", Capacidad: 1}}
+	case EscenarioInanicion:
+		return []EquipoCocina{{Nombre: "Horno", Capacidad: 1}}
+	}
+	return nil
+}
+
+// Cocineros retorna los cocineros del escenario según si está corregido
+func (e Escenario) Cocineros() []CocineroEscenario {
+	switch e.Tipo {
+	case EscenarioInterbloqueo:
+		ordenB := []string{"Tabla", "Cuchillo"} // Orden opuesto al del cocinero A
+		if e.Corregido {
+			ordenB = []string{"Cuchillo", "Tabla"} // Mismo orden global: no puede haber ciclo
+		}
+		return []CocineroEscenario{
+			{Nombre: "Cocinero A", Recursos: []string{"Cuchillo", "Tabla"},
+				Preparacion: 400 * time.Millisecond, Trabajo: 800 * time.Millisecond, Pausa: 300 * time.Millisecond},
+			{Nombre: "Cocinero B", Recursos: ordenB,
+				Preparacion: 400 * time.Millisecond, Trabajo: 800 * time.Millisecond, Pausa: 300 * time.Millisecond},
+		}
+	case EscenarioInanicion:
+		return []CocineroEscenario{
+			{Nombre: "Acaparador", Recursos: []string{"Horno"}, Trabajo: time.Second},
+			{Nombre: "Paciente", Recursos: []string{"Horno"}, Trabajo: 500 * time.Millisecond,
+				Pausa: 300 * time.Millisecond, Reintenta: !e.Corregido},
+		}
+	}
+	return nil
+}
+
+// UmbralAtasco es cuánto tiempo de juego puede esperar una goroutine antes de que el vigilante la reporte
+const UmbralAtasco = 3 * time.Second
+
+// Atasco es una goroutine que lleva esperando un recurso más que el umbral
+type Atasco struct {
+	Quien   string
+	Recurso string
+	Espera  time.Duration
+}
+
+// ReporteBloqueo es lo que encontró el vigilante en su última revisión
+type ReporteBloqueo struct {
+	Atascados []Atasco
+	Ciclo     []string // Quien -> recurso -> quien ... (vacío si no hay interbloqueo)
+}
+
+// HayInterbloqueo indica si el vigilante encontró un ciclo de esperas
+func (r ReporteBloqueo) HayInterbloqueo() bool {
+	return len(r.Ciclo) > 0
+}
+
+// DescribirCiclo retorna el ciclo cerrado (ej. "Cocinero A -> Tabla -> Cocinero B -> Cuchillo -> Cocinero A")
+func (r ReporteBloqueo) DescribirCiclo() string {
+	if !r.HayInterbloqueo() {
+		return ""
+	}
+	return strings.Join(append(slices.Clone(r.Ciclo), r.Ciclo[0]), " -> ")
+}
+
+// BuscarCiclo busca un ciclo en el grafo de esperas: cada goroutine espera un recurso
+// (esperas) y cada recurso lo tienen otras goroutines (titulares)
+// Retorna el ciclo alternando goroutine y recurso, empezando por la primera en orden alfabético
+func BuscarCiclo(esperas map[string]string, titulares map[string][]string) []string {
+	quienes := make([]string, 0, len(esperas))
+	for quien := range esperas {
+		quienes = append(quienes, quien)
+	}
+	slices.Sort(quienes)
+
+	for _, inicio := range quienes {
+		if ciclo := seguirEsperas(inicio, esperas, titulares); ciclo != nil {
+			return ciclo
+		}
+	}
+	return nil
+}
+
+// seguirEsperas recorre "espera un recurso que tiene otro" desde inicio hasta volver a él
+func seguirEsperas(inicio string, esperas map[string]string, titulares map[string][]string) []string {
+	camino := []string{}
+	visitados := map[string]bool{}
+	actual := inicio
+
+	for !visitados[actual] {
+		visitados[actual] = true
+		recurso, espera := esperas[actual]
+		if !espera {
+			return nil
+		}
+		camino = append(camino, actual, recurso)
+
+		// Seguir al primer titular que también está esperando (o cerrar el ciclo)
+		siguiente := ""
+		for _, titular := range titulares[recurso] {
+			if titular == inicio {
+				return camino
+			}
+			if _, esperaTambien := esperas[titular]; esperaTambien && siguiente == "" {
+				siguiente = titular
+			}
+		}
+		if siguiente == "" {
+			return nil
+		}
+		actual = siguiente
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBuscarCiclo(t *testing.T) {
+	casos := []struct {
+		nombre    string
+		esperas   map[string]string   // Quién espera qué recurso
+		titulares map[string][]string // Quién tiene cada recurso
+		ciclo     []string
+	}{
+		{
+			nombre: "sin esperas",
+		},
+		{
+			nombre:    "espera a quien no espera",
+			esperas:   map[string]string{"A": "Horno"},
+			titulares: map[string][]string{"Horno": {"B"}},
+		},
+		{
+			nombre:    "dos cocineros cruzados",
+			esperas:   map[string]string{"B": "Cuchillo", "A": "Tabla"},
+			titulares: map[string][]string{"Cuchillo": {"A"}, "Tabla": {"B"}},
+			ciclo:     []string{"A", "Tabla", "B", "Cuchillo"},
+		},
+		{
+			nombre:    "cadena sin cerrar",
+			esperas:   map[string]string{"A": "Tabla", "B": "Cuchillo"},
+			titulares: map[string][]string{"Tabla": {"B"}, "Cuchillo": {"C"}},
+		},
+		{
+			nombre:    "tres cocineros en ronda",
+			esperas:   map[string]string{"A": "Tabla", "B": "Horno", "C": "Cuchillo"},
+			titulares: map[string][]string{"Tabla": {"B"}, "Horno": {"C"}, "Cuchillo": {"A"}},
+			ciclo:     []string{"A", "Tabla", "B", "Horno", "C", "Cuchillo"},
+		},
+		{
+			nombre:    "recurso compartido con un titular que no espera",
+			esperas:   map[string]string{"A": "Horno", "B": "Tabla"},
+			titulares: map[string][]string{"Horno": {"C", "B"}, "Tabla": {"A"}},
+			ciclo:     []string{"A", "Horno", "B", "Tabla"},
+		},
+		{
+			nombre:    "ciclo que no pasa por el primero",
+			esperas:   map[string]string{"A": "Tabla", "B": "Cuchillo", "C": "Tabla"},
+			titulares: map[string][]string{"Tabla": {"B"}, "Cuchillo": {"C"}},
+			ciclo:     []string{"B", "Cuchillo", "C", "Tabla"},
+		},
+		{
+			nombre:    "espera un recurso que ya tiene",
+			esperas:   map[string]string{"A": "Horno"},
+			titulares: map[string][]string{"Horno": {"A"}},
+			ciclo:     []string{"A", "Horno"},
+		},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			if ciclo := BuscarCiclo(caso.esperas, caso.titulares); !slices.Equal(ciclo, caso.ciclo) {
+				t.Fatalf("ciclo %v, se esperaba %v", ciclo, caso.ciclo)
+			}
+		})
+	}
+}
+
+func TestDescribirCicloLoCierra(t *testing.T) {
+	reporte := ReporteBloqueo{Ciclo: []string{"Cocinero A", "Tabla", "Cocinero B", "Cuchillo"}}
+	if descripcion := reporte.DescribirCiclo(); descripcion != "Cocinero A -> Tabla -> Cocinero B -> Cuchillo -> Cocinero A" {
+		t.Fatalf("descripción %q", descripcion)
+	}
+	if (ReporteBloqueo{}).DescribirCiclo() != "" {
+		t.Fatal("sin ciclo la descripción no está vacía")
+	}
+}
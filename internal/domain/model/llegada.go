@@ -0,0 +1,218 @@
+package model
+
+import (
+	"math/rand"
+	"time"
+)
+
+// TipoLlegada identifica el proceso con el que llegan los clientes
+type TipoLlegada string
+
+const (
+	LlegadaCurva      TipoLlegada = "curva"      // Cada intervalo, probabilidad por mesa libre (según CurvaLlegada)
+	LlegadaPoisson    TipoLlegada = "poisson"    // Proceso de Poisson con tasa constante
+	LlegadaProgramada TipoLlegada = "programada" // Tasa por tramos (hora pico, horas tranquilas)
+	LlegadaRafagas    TipoLlegada = "rafagas"    // Poisson de base más ráfagas periódicas de grupos
+)
+
+// esperaSinLlegadas es cuánto se vuelve a consultar cuando la tasa actual es cero
+const esperaSinLlegadas = time.Second
+
+// TramoLlegada es un tramo de un horario programado: desde Desde rige TasaPorMinuto
+type TramoLlegada struct {
+	Nombre        string
+	Desde         time.Duration
+	TasaPorMinuto float64 // Grupos por minuto
+}
+
+// PesoGrupo es una entrada de la distribución de tamaños de grupo
+type PesoGrupo struct {
+	Tamano int
+	Peso   float64
+}
+
+// PatronLlegada configura el proceso de llegada de clientes de un nivel
+type PatronLlegada struct {
+	Tipo          TipoLlegada
+	TasaPorMinuto float64        // Poisson y base de ráfagas (grupos por minuto)
+	Tramos        []TramoLlegada // Horario programado, ordenado por Desde
+	PeriodoRafaga time.Duration  // Cada cuánto llega una ráfaga
+	TamanoRafaga  int            // Grupos que llegan juntos en una ráfaga
+	Grupos        []PesoGrupo    // Distribución de tamaño de grupo (vacía = 1 a 3 uniforme)
+}
+
+// TamanoGrupo sortea cuántos clientes llegan juntos según la distribución configurada
+func (p PatronLlegada) TamanoGrupo() int {
+	total := 0.0
+	for _, g := range p.Grupos {
+		total += g.Peso
+	}
+	if total <= 0 {
+		return rand.Intn(3) + 1
+	}
+
+	sorteo := rand.Float64() * total
+	for _, g := range p.Grupos {
+		sorteo -= g.Peso
+		if sorteo < 0 {
+			return g.Tamano
+		}
+	}
+	return p.Grupos[len(p.Grupos)-1].Tamano
+}
+
+// ProcesoLlegada decide cuándo llegan clientes y cuántos grupos llegan a la vez
+// Cada turno usa un proceso nuevo; solo lo usa la goroutine generadora
+type ProcesoLlegada interface {
+	// Espera retorna cuánto falta para la próxima llegada
+	Espera(transcurrido time.Duration) time.Duration
+	// Grupos retorna cuántos grupos llegan ahora (pueden superar a las mesas libres)
+	Grupos(transcurrido time.Duration, mesasLibres int) int
+}
+
+// NuevoProcesoLlegada crea el proceso de llegadas del nivel
+func (n Nivel) NuevoProcesoLlegada() ProcesoLlegada {
+	return n.NuevoProcesoLlegadaConSemilla(time.Now().UnixNano())
+}
+
+// NuevoProcesoLlegadaConSemilla crea el proceso de llegadas con su propio generador
+// aleatorio: con la misma semilla sortea siempre las mismas esperas (pruebas)
+func (n Nivel) NuevoProcesoLlegadaConSemilla(semilla int64) ProcesoLlegada {
+	azar := rand.New(rand.NewSource(semilla))
+
+	switch n.Llegadas.Tipo {
+	case LlegadaPoisson:
+		return &llegadaPoisson{azar: azar, tasa: n.Llegadas.TasaPorMinuto}
+	case LlegadaProgramada:
+		return &llegadaProgramada{azar: azar, tramos: n.Llegadas.Tramos}
+	case LlegadaRafagas:
+		return &llegadaRafagas{
+			azar:          azar,
+			tasa:          n.Llegadas.TasaPorMinuto,
+			periodo:       n.Llegadas.PeriodoRafaga,
+			tamano:        n.Llegadas.TamanoRafaga,
+			proximaRafaga: n.Llegadas.PeriodoRafaga,
+		}
+	default:
+		return &llegadaCurva{azar: azar, nivel: n}
+	}
+}
+
+// esperaExponencial sortea el tiempo entre llegadas de un proceso de Poisson
+func esperaExponencial(azar *rand.Rand, tasaPorMinuto float64) time.Duration {
+	if tasaPorMinuto <= 0 {
+		return esperaSinLlegadas
+	}
+	minutos := azar.ExpFloat64() / tasaPorMinuto
+	return time.Duration(minutos * float64(time.Minute))
+}
+
+// llegadaCurva es el comportamiento original: cada intervalo, cada mesa libre
+// recibe clientes con la probabilidad de la curva del nivel
+type llegadaCurva struct {
+	azar  *rand.Rand
+	nivel Nivel
+}
+
+func (l *llegadaCurva) Espera(time.Duration) time.Duration {
+	return l.nivel.IntervaloLlegada
+}
+
+func (l *llegadaCurva) Grupos(transcurrido time.Duration, mesasLibres int) int {
+	probabilidad := l.nivel.ProbabilidadLlegada(transcurrido)
+	grupos := 0
+	for i := 0; i < mesasLibres; i++ {
+		if l.azar.Float64() < probabilidad {
+			grupos++
+		}
+	}
+	return grupos
+}
+
+// llegadaPoisson llega un grupo a la vez con tiempos exponenciales
+type llegadaPoisson struct {
+	azar *rand.Rand
+	tasa float64
+}
+
+func (l *llegadaPoisson) Espera(time.Duration) time.Duration {
+	return esperaExponencial(l.azar, l.tasa)
+}
+
+func (l *llegadaPoisson) Grupos(time.Duration, int) int {
+	return 1
+}
+
+// llegadaProgramada es un Poisson cuya tasa cambia por tramos del turno
+type llegadaProgramada struct {
+	azar        *rand.Rand
+	tramos      []TramoLlegada
+	cambioTramo bool // La última espera terminaba en un cambio de tramo, no en una llegada
+}
+
+// tramoActual retorna el índice del tramo vigente (-1 si todavía no empieza ninguno)
+func (l *llegadaProgramada) tramoActual(transcurrido time.Duration) int {
+	actual := -1
+	for i, tramo := range l.tramos {
+		if transcurrido >= tramo.Desde {
+			actual = i
+		}
+	}
+	return actual
+}
+
+func (l *llegadaProgramada) Espera(transcurrido time.Duration) time.Duration {
+	i := l.tramoActual(transcurrido)
+	tasa := 0.0
+	if i >= 0 {
+		tasa = l.tramos[i].TasaPorMinuto
+	}
+	espera := esperaExponencial(l.azar, tasa)
+
+	// No dormir más allá del cambio de tramo: la tasa nueva rige desde ahí
+	l.cambioTramo = false
+	if i+1 < len(l.tramos) {
+		hastaCambio := l.tramos[i+1].Desde - transcurrido
+		if tasa <= 0 || hastaCambio < espera {
+			l.cambioTramo = true
+			return hastaCambio
+		}
+	}
+	return espera
+}
+
+func (l *llegadaProgramada) Grupos(transcurrido time.Duration, _ int) int {
+	i := l.tramoActual(transcurrido)
+	if l.cambioTramo || i < 0 || l.tramos[i].TasaPorMinuto <= 0 {
+		return 0
+	}
+	return 1
+}
+
+// llegadaRafagas combina un Poisson de base con ráfagas periódicas de varios grupos
+type llegadaRafagas struct {
+	azar          *rand.Rand
+	tasa          float64
+	periodo       time.Duration
+	tamano        int
+	proximaRafaga time.Duration
+}
+
+func (l *llegadaRafagas) Espera(transcurrido time.Duration) time.Duration {
+	espera := esperaExponencial(l.azar, l.tasa)
+	if hastaRafaga := l.proximaRafaga - transcurrido; l.periodo > 0 && hastaRafaga < espera {
+		return hastaRafaga
+	}
+	return espera
+}
+
+func (l *llegadaRafagas) Grupos(transcurrido time.Duration, _ int) int {
+	if l.periodo > 0 && transcurrido >= l.proximaRafaga {
+		l.proximaRafaga += l.periodo
+		return l.tamano
+	}
+	if l.tasa <= 0 {
+		return 0
+	}
+	return 1
+}
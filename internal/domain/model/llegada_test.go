@@ -0,0 +1,172 @@
+package model
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+// llegada es un evento del proceso simulado: en qué momento del turno y cuántos grupos
+type llegada struct {
+	instante time.Duration
+	grupos   int
+}
+
+// simularLlegadas recorre el proceso como la goroutine generadora hasta el final del turno
+func simularLlegadas(proceso ProcesoLlegada, turno time.Duration, mesasLibres int) []llegada {
+	var llegadas []llegada
+	transcurrido := time.Duration(0)
+	for {
+		transcurrido += proceso.Espera(transcurrido)
+		if transcurrido > turno {
+			return llegadas
+		}
+		llegadas = append(llegadas, llegada{transcurrido, proceso.Grupos(transcurrido, mesasLibres)})
+	}
+}
+
+// nivelConLlegadas retorna el nivel por defecto con otro patrón de llegada
+func nivelConLlegadas(patron PatronLlegada) Nivel {
+	nivel := NivelPorDefecto()
+	nivel.Llegadas = patron
+	return nivel
+}
+
+func TestLlegadasConLaMismaSemillaSeRepiten(t *testing.T) {
+	patrones := []PatronLlegada{
+		{Tipo: LlegadaCurva},
+		{Tipo: LlegadaPoisson, TasaPorMinuto: 6},
+		{Tipo: LlegadaProgramada, Tramos: []TramoLlegada{{Desde: 0, TasaPorMinuto: 2}, {Desde: time.Minute, TasaPorMinuto: 10}}},
+		{Tipo: LlegadaRafagas, TasaPorMinuto: 3, PeriodoRafaga: 40 * time.Second, TamanoRafaga: 4},
+	}
+	for _, patron := range patrones {
+		t.Run(string(patron.Tipo), func(t *testing.T) {
+			nivel := nivelConLlegadas(patron)
+			primera := simularLlegadas(nivel.NuevoProcesoLlegadaConSemilla(42), 3*time.Minute, 3)
+			segunda := simularLlegadas(nivel.NuevoProcesoLlegadaConSemilla(42), 3*time.Minute, 3)
+			if len(primera) == 0 {
+				t.Fatal("el proceso no generó llegadas")
+			}
+			if !slices.Equal(primera, segunda) {
+				t.Fatalf("la misma semilla dio llegadas distintas:\n%v\n%v", primera, segunda)
+			}
+		})
+	}
+}
+
+func TestLlegadaCurvaEsperaElIntervaloDelNivel(t *testing.T) {
+	nivel := nivelConLlegadas(PatronLlegada{Tipo: LlegadaCurva})
+	nivel.IntervaloLlegada = 5 * time.Second
+
+	casos := []struct {
+		nombre       string
+		probabilidad float64
+		grupos       int
+	}{
+		{"nunca", 0, 0},
+		{"siempre", 1, 4},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			nivel.CurvaLlegada = []PuntoLlegada{{Desde: 0, Probabilidad: caso.probabilidad}}
+			llegadas := simularLlegadas(nivel.NuevoProcesoLlegadaConSemilla(1), time.Minute, 4)
+			if len(llegadas) != 12 {
+				t.Fatalf("se esperaban 12 intervalos en un minuto, hubo %d", len(llegadas))
+			}
+			for i, l := range llegadas {
+				if l.instante != time.Duration(i+1)*nivel.IntervaloLlegada || l.grupos != caso.grupos {
+					t.Fatalf("llegada %d: %v, se esperaba %d grupos cada %v", i, l, caso.grupos, nivel.IntervaloLlegada)
+				}
+			}
+		})
+	}
+}
+
+func TestLlegadaPoissonRespetaLaTasa(t *testing.T) {
+	casos := []struct {
+		tasa   float64
+		espera time.Duration // Espera media esperada
+	}{
+		{tasa: 2, espera: 30 * time.Second},
+		{tasa: 6, espera: 10 * time.Second},
+		{tasa: 0, espera: esperaSinLlegadas},
+	}
+	for _, caso := range casos {
+		nivel := nivelConLlegadas(PatronLlegada{Tipo: LlegadaPoisson, TasaPorMinuto: caso.tasa})
+		proceso := nivel.NuevoProcesoLlegadaConSemilla(7)
+
+		const muestras = 5000
+		total := time.Duration(0)
+		for range muestras {
+			espera := proceso.Espera(0)
+			if espera < 0 {
+				t.Fatalf("tasa %.0f: espera negativa %v", caso.tasa, espera)
+			}
+			total += espera
+		}
+		media := total / muestras
+		if desvio := math.Abs(float64(media-caso.espera)) / float64(caso.espera); desvio > 0.05 {
+			t.Errorf("tasa %.0f: espera media %v, se esperaba %v", caso.tasa, media, caso.espera)
+		}
+		if grupos := proceso.Grupos(0, 3); grupos != 1 {
+			t.Errorf("tasa %.0f: llegaron %d grupos juntos", caso.tasa, grupos)
+		}
+	}
+}
+
+func TestLlegadaProgramadaNoSeSaltaElCambioDeTramo(t *testing.T) {
+	tramos := []TramoLlegada{
+		{Nombre: "Apertura", Desde: 0, TasaPorMinuto: 0},
+		{Nombre: "Pico", Desde: time.Minute, TasaPorMinuto: 30},
+		{Nombre: "Calma", Desde: 2 * time.Minute, TasaPorMinuto: 0},
+	}
+	nivel := nivelConLlegadas(PatronLlegada{Tipo: LlegadaProgramada, Tramos: tramos})
+	llegadas := simularLlegadas(nivel.NuevoProcesoLlegadaConSemilla(3), 3*time.Minute, 3)
+
+	// Sin tasa se duerme hasta el cambio de tramo y ahí no llega nadie
+	if llegadas[0] != (llegada{time.Minute, 0}) {
+		t.Fatalf("primera llegada %v, se esperaba el cambio de tramo sin grupos", llegadas[0])
+	}
+	enPico := 0
+	for _, l := range llegadas[1:] {
+		if l.instante > 2*time.Minute {
+			if l.grupos != 0 {
+				t.Fatalf("llegaron %d grupos en el tramo sin tasa (%v)", l.grupos, l.instante)
+			}
+			continue
+		}
+		enPico += l.grupos
+	}
+	if !slices.Contains(llegadas, llegada{2 * time.Minute, 0}) {
+		t.Fatal("el proceso se salteó el cambio al tramo de calma")
+	}
+	if enPico < 20 || enPico > 40 {
+		t.Fatalf("en el pico de 30 por minuto llegaron %d grupos", enPico)
+	}
+}
+
+func TestLlegadaRafagasLlegaEnCadaPeriodo(t *testing.T) {
+	nivel := nivelConLlegadas(PatronLlegada{
+		Tipo:          LlegadaRafagas,
+		TasaPorMinuto: 4,
+		PeriodoRafaga: 30 * time.Second,
+		TamanoRafaga:  5,
+	})
+	llegadas := simularLlegadas(nivel.NuevoProcesoLlegadaConSemilla(11), 2*time.Minute, 3)
+
+	var rafagas []time.Duration
+	for _, l := range llegadas {
+		switch l.grupos {
+		case 5:
+			rafagas = append(rafagas, l.instante)
+		case 1:
+		default:
+			t.Fatalf("llegaron %d grupos en %v (solo 1 o una ráfaga de 5)", l.grupos, l.instante)
+		}
+	}
+	esperadas := []time.Duration{30 * time.Second, time.Minute, 90 * time.Second, 2 * time.Minute}
+	if !slices.Equal(rafagas, esperadas) {
+		t.Fatalf("ráfagas en %v, se esperaban en %v", rafagas, esperadas)
+	}
+}
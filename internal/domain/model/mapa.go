@@ -0,0 +1,296 @@
+package model
+
+import (
+	"container/heap"
+	"math"
+)
+
+// Punto es una posición en el salón
+type Punto struct {
+	X, Y float64
+}
+
+// Rect es un rectángulo de colisión (esquina superior izquierda y tamaño)
+type Rect struct {
+	X, Y, W, H float64
+}
+
+// Intersecta indica si dos rectángulos se superponen
+func (r Rect) Intersecta(o Rect) bool {
+	return r.X < o.X+o.W && o.X < r.X+r.W && r.Y < o.Y+o.H && o.Y < r.Y+r.H
+}
+
+// Mapa es el salón caminable: límites de pantalla, obstáculos (mesas, barra) y una
+// grilla para buscar rutas con A*
+type Mapa struct {
+	Ancho, Alto float64
+	Celda       float64
+	obstaculos  []Rect
+
+	// Grilla: una celda está bloqueada si el mesero centrado en ella choca con algo
+	cols, filas int
+	bloqueada   []bool
+	agenteW     float64
+	agenteH     float64
+}
+
+// NewMapa crea el mapa y precalcula la grilla para un agente de tamaño agenteW x agenteH
+func NewMapa(ancho, alto, celda float64, obstaculos []Rect, agenteW, agenteH float64) *Mapa {
+	m := &Mapa{
+		Ancho:      ancho,
+		Alto:       alto,
+		Celda:      celda,
+		obstaculos: obstaculos,
+		cols:       int(ancho / celda),
+		filas:      int(alto / celda),
+		agenteW:    agenteW,
+		agenteH:    agenteH,
+	}
+
+	m.bloqueada = make([]bool, m.cols*m.filas)
+	for f := 0; f < m.filas; f++ {
+		for c := 0; c < m.cols; c++ {
+			centro := m.centroCelda(c, f)
+			m.bloqueada[f*m.cols+c] = !m.Libre(m.rectAgente(centro.X, centro.Y))
+		}
+	}
+	return m
+}
+
+// Libre indica si un rectángulo está dentro de la pantalla y no choca con obstáculos
+func (m *Mapa) Libre(r Rect) bool {
+	if r.X < 0 || r.Y < 0 || r.X+r.W > m.Ancho || r.Y+r.H > m.Alto {
+		return false
+	}
+	for _, o := range m.obstaculos {
+		if r.Intersecta(o) {
+			return false
+		}
+	}
+	return true
+}
+
+// rectAgente retorna el rectángulo del agente centrado en (x, y)
+func (m *Mapa) rectAgente(x, y float64) Rect {
+	return Rect{X: x - m.agenteW/2, Y: y - m.agenteH/2, W: m.agenteW, H: m.agenteH}
+}
+
+func (m *Mapa) centroCelda(c, f int) Punto {
+	return Punto{X: (float64(c) + 0.5) * m.Celda, Y: (float64(f) + 0.5) * m.Celda}
+}
+
+func (m *Mapa) celdaDe(x, y float64) (c, f int) {
+	c = int(x / m.Celda)
+	f = int(y / m.Celda)
+	return max(0, min(c, m.cols-1)), max(0, min(f, m.filas-1))
+}
+
+func (m *Mapa) caminable(c, f int) bool {
+	return c >= 0 && f >= 0 && c < m.cols && f < m.filas && !m.bloqueada[f*m.cols+c]
+}
+
+// Ruta busca con A* un camino para el agente desde (desdeX, desdeY) hasta (hastaX, hastaY)
+// Retorna los puntos a recorrer (sin incluir el origen) o nil si no hay camino
+// Si el destino está bloqueado se usa la celda libre más cercana
+func (m *Mapa) Ruta(desdeX, desdeY, hastaX, hastaY float64) []Punto {
+	destino := Punto{X: hastaX, Y: hastaY}
+	if !m.Libre(m.rectAgente(hastaX, hastaY)) {
+		c, f, ok := m.celdaLibreCercana(m.celdaDe(hastaX, hastaY))
+		if !ok {
+			return nil
+		}
+		destino = m.centroCelda(c, f)
+	}
+
+	// Camino directo si no hay nada en medio
+	if m.lineaLibre(Punto{X: desdeX, Y: desdeY}, destino) {
+		return []Punto{destino}
+	}
+
+	origenC, origenF := m.celdaDe(desdeX, desdeY)
+	if !m.caminable(origenC, origenF) {
+		var ok bool
+		if origenC, origenF, ok = m.celdaLibreCercana(origenC, origenF); !ok {
+			return nil
+		}
+	}
+	destinoC, destinoF := m.celdaDe(destino.X, destino.Y)
+	if !m.caminable(destinoC, destinoF) {
+		var ok bool
+		if destinoC, destinoF, ok = m.celdaLibreCercana(destinoC, destinoF); !ok {
+			return nil
+		}
+	}
+
+	celdas := m.aEstrella(origenF*m.cols+origenC, destinoF*m.cols+destinoC)
+	if celdas == nil {
+		return nil
+	}
+
+	ruta := make([]Punto, 0, len(celdas)+1)
+	for _, i := range celdas[1:] {
+		ruta = append(ruta, m.centroCelda(i%m.cols, i/m.cols))
+	}
+	ruta = append(ruta, destino)
+
+	return m.suavizar(Punto{X: desdeX, Y: desdeY}, ruta)
+}
+
+// aEstrella busca el camino de celdas (8 direcciones, sin cortar esquinas)
+func (m *Mapa) aEstrella(origen, destino int) []int {
+	costo := make(map[int]float64, 256)
+	previo := make(map[int]int, 256)
+	cerrada := make(map[int]bool, 256)
+
+	abierta := &colaPrioridad{}
+	costo[origen] = 0
+	heap.Push(abierta, nodoRuta{celda: origen, prioridad: m.heuristica(origen, destino)})
+
+	for abierta.Len() > 0 {
+		actual := heap.Pop(abierta).(nodoRuta).celda
+		if actual == destino {
+			return reconstruirRuta(previo, origen, destino)
+		}
+		if cerrada[actual] {
+			continue
+		}
+		cerrada[actual] = true
+
+		c, f := actual%m.cols, actual/m.cols
+		for _, d := range direccionesRuta {
+			nc, nf := c+d[0], f+d[1]
+			if !m.caminable(nc, nf) {
+				continue
+			}
+			// En diagonal, ambos vecinos ortogonales deben estar libres
+			if d[0] != 0 && d[1] != 0 && (!m.caminable(c+d[0], f) || !m.caminable(c, f+d[1])) {
+				continue
+			}
+
+			vecino := nf*m.cols + nc
+			nuevoCosto := costo[actual] + math.Hypot(float64(d[0]), float64(d[1]))
+			if anterior, visto := costo[vecino]; visto && nuevoCosto >= anterior {
+				continue
+			}
+			costo[vecino] = nuevoCosto
+			previo[vecino] = actual
+			heap.Push(abierta, nodoRuta{celda: vecino, prioridad: nuevoCosto + m.heuristica(vecino, destino)})
+		}
+	}
+	return nil
+}
+
+// heuristica es la distancia octil entre dos celdas
+func (m *Mapa) heuristica(a, b int) float64 {
+	dx := math.Abs(float64(a%m.cols - b%m.cols))
+	dy := math.Abs(float64(a/m.cols - b/m.cols))
+	return dx + dy + (math.Sqrt2-2)*math.Min(dx, dy)
+}
+
+// celdaLibreCercana busca la celda caminable más cercana en anillos crecientes
+func (m *Mapa) celdaLibreCercana(c, f int) (int, int, bool) {
+	for radio := 1; radio < max(m.cols, m.filas); radio++ {
+		for dc := -radio; dc <= radio; dc++ {
+			for df := -radio; df <= radio; df++ {
+				if max(abs(dc), abs(df)) == radio && m.caminable(c+dc, f+df) {
+					return c + dc, f + df, true
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// PuntoLibreCercano retorna el centro de la celda caminable más cercana a (x, y) que
+// además acepta libre (ej. sin otros meseros encima); ok = false si no hay ninguna
+func (m *Mapa) PuntoLibreCercano(x, y float64, libre func(Rect) bool) (Punto, bool) {
+	c, f := m.celdaDe(x, y)
+	for radio := 0; radio < max(m.cols, m.filas); radio++ {
+		for dc := -radio; dc <= radio; dc++ {
+			for df := -radio; df <= radio; df++ {
+				if max(abs(dc), abs(df)) != radio || !m.caminable(c+dc, f+df) {
+					continue
+				}
+				if centro := m.centroCelda(c+dc, f+df); libre(m.rectAgente(centro.X, centro.Y)) {
+					return centro, true
+				}
+			}
+		}
+	}
+	return Punto{}, false
+}
+
+// lineaLibre verifica si el agente puede ir en línea recta entre dos puntos
+func (m *Mapa) lineaLibre(a, b Punto) bool {
+	distancia := math.Hypot(b.X-a.X, b.Y-a.Y)
+	pasos := int(distancia/4) + 1
+	for i := 0; i <= pasos; i++ {
+		t := float64(i) / float64(pasos)
+		if !m.Libre(m.rectAgente(a.X+(b.X-a.X)*t, a.Y+(b.Y-a.Y)*t)) {
+			return false
+		}
+	}
+	return true
+}
+
+// suavizar elimina puntos intermedios cuando hay línea recta libre entre otros más lejanos
+func (m *Mapa) suavizar(origen Punto, ruta []Punto) []Punto {
+	suavizada := make([]Punto, 0, len(ruta))
+	desde := origen
+	for i := 0; i < len(ruta); {
+		// Saltar al punto más lejano visible desde la posición actual
+		j := len(ruta) - 1
+		for j > i && !m.lineaLibre(desde, ruta[j]) {
+			j--
+		}
+		suavizada = append(suavizada, ruta[j])
+		desde = ruta[j]
+		i = j + 1
+	}
+	return suavizada
+}
+
+func reconstruirRuta(previo map[int]int, origen, destino int) []int {
+	ruta := []int{destino}
+	for actual := destino; actual != origen; {
+		actual = previo[actual]
+		ruta = append(ruta, actual)
+	}
+	for i, j := 0, len(ruta)-1; i < j; i, j = i+1, j-1 {
+		ruta[i], ruta[j] = ruta[j], ruta[i]
+	}
+	return ruta
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+var direccionesRuta = [8][2]int{
+	{1, 0}, {-1, 0}, {0, 1}, {0, -1},
+	{1, 1}, {1, -1}, {-1, 1}, {-1, -1},
+}
+
+// nodoRuta es una celda en la lista abierta de A*
+type nodoRuta struct {
+	celda     int
+	prioridad float64
+}
+
+// colaPrioridad implementa heap.Interface ordenando por menor prioridad
+type colaPrioridad []nodoRuta
+
+func (c colaPrioridad) Len() int            { return len(c) }
+func (c colaPrioridad) Less(i, j int) bool  { return c[i].prioridad < c[j].prioridad }
+func (c colaPrioridad) Swap(i, j int)       { c[i], c[j] = c[j], c[i] }
+func (c *colaPrioridad) Push(x interface{}) { *c = append(*c, x.(nodoRuta)) }
+func (c *colaPrioridad) Pop() interface{} {
+	viejo := *c
+	n := len(viejo)
+	nodo := viejo[n-1]
+	*c = viejo[:n-1]
+	return nodo
+}
@@ -0,0 +1,137 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+// Salón de prueba: 320x320 con celdas de 16 y una pared vertical en el medio
+const (
+	ladoSalon  = 320.0
+	celdaSalon = 16.0
+)
+
+// paredConPaso deja un paso libre debajo de la pared
+var paredConPaso = Rect{X: 140, Y: 0, W: 40, H: 240}
+
+// paredCompleta divide el salón en dos
+var paredCompleta = Rect{X: 140, Y: 0, W: 40, H: ladoSalon}
+
+func nuevoSalon(obstaculos ...Rect) *Mapa {
+	return NewMapa(ladoSalon, ladoSalon, celdaSalon, obstaculos, AnchoMesero, AltoMesero)
+}
+
+// rutaLibre verifica que el mesero pueda recorrer la ruta en línea recta de punto a punto
+func rutaLibre(t *testing.T, m *Mapa, desde Punto, ruta []Punto) {
+	t.Helper()
+	for _, punto := range ruta {
+		if !m.lineaLibre(desde, punto) {
+			t.Fatalf("el tramo %v -> %v atraviesa un obstáculo (ruta %v)", desde, punto, ruta)
+		}
+		desde = punto
+	}
+}
+
+func TestMapaRuta(t *testing.T) {
+	casos := []struct {
+		nombre       string
+		obstaculos   []Rect
+		desde        Punto
+		hasta        Punto
+		sinCamino    bool
+		tramos       int  // Cantidad exacta de puntos (0 = cualquiera)
+		corrido      bool // El destino está bloqueado: la ruta termina cerca, en un lugar libre
+		pasaPorAbajo bool // La ruta baja hasta el paso de la pared
+	}{
+		{
+			nombre: "línea recta sin obstáculos",
+			desde:  Punto{40, 40}, hasta: Punto{280, 280},
+			tramos: 1,
+		},
+		{
+			nombre:     "línea recta por el paso",
+			obstaculos: []Rect{paredConPaso},
+			desde:      Punto{40, 290}, hasta: Punto{280, 290},
+			tramos: 1,
+		},
+		{
+			nombre:     "rodea la pared",
+			obstaculos: []Rect{paredConPaso},
+			desde:      Punto{40, 60}, hasta: Punto{280, 60},
+			pasaPorAbajo: true,
+		},
+		{
+			nombre:     "destino dentro de la pared",
+			obstaculos: []Rect{paredConPaso},
+			desde:      Punto{40, 60}, hasta: Punto{160, 60},
+			corrido: true,
+		},
+		{
+			nombre:     "encerrado del otro lado",
+			obstaculos: []Rect{paredCompleta},
+			desde:      Punto{40, 60}, hasta: Punto{280, 60},
+			sinCamino: true,
+		},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			m := nuevoSalon(caso.obstaculos...)
+			ruta := m.Ruta(caso.desde.X, caso.desde.Y, caso.hasta.X, caso.hasta.Y)
+			if caso.sinCamino {
+				if ruta != nil {
+					t.Fatalf("se encontró una ruta a través de la pared: %v", ruta)
+				}
+				return
+			}
+			if len(ruta) == 0 {
+				t.Fatal("no se encontró ruta")
+			}
+			if caso.tramos > 0 && len(ruta) != caso.tramos {
+				t.Fatalf("ruta %v, se esperaban %d puntos", ruta, caso.tramos)
+			}
+			final := ruta[len(ruta)-1]
+			if caso.corrido {
+				if !m.Libre(m.rectAgente(final.X, final.Y)) || math.Hypot(final.X-caso.hasta.X, final.Y-caso.hasta.Y) > 4*celdaSalon {
+					t.Fatalf("la ruta termina en %v, lejos de %v o dentro de la pared", final, caso.hasta)
+				}
+			} else if final != caso.hasta {
+				t.Fatalf("la ruta termina en %v, se esperaba %v", final, caso.hasta)
+			}
+			rutaLibre(t, m, caso.desde, ruta)
+
+			bajo := false
+			for _, punto := range ruta {
+				bajo = bajo || punto.Y-AltoMesero/2 >= paredConPaso.Y+paredConPaso.H
+			}
+			if caso.pasaPorAbajo && !bajo {
+				t.Fatalf("la ruta no baja hasta el paso de la pared: %v", ruta)
+			}
+		})
+	}
+}
+
+func TestMapaPuntoLibreCercano(t *testing.T) {
+	m := nuevoSalon(paredConPaso)
+
+	punto, ok := m.PuntoLibreCercano(160, 60, m.Libre)
+	if !ok || !m.Libre(m.rectAgente(punto.X, punto.Y)) {
+		t.Fatalf("punto libre %v (ok = %v) dentro de la pared", punto, ok)
+	}
+	if distancia := math.Hypot(punto.X-160, punto.Y-60); distancia > 4*celdaSalon {
+		t.Fatalf("el punto libre %v está a %.0f de la pared", punto, distancia)
+	}
+
+	// Un lugar libre se retorna tal cual (la celda que lo contiene)
+	if punto, ok := m.PuntoLibreCercano(40, 60, m.Libre); !ok || punto != (Punto{40, 56}) {
+		t.Fatalf("en un lugar libre se obtuvo %v (ok = %v)", punto, ok)
+	}
+
+	if _, ok := m.PuntoLibreCercano(160, 60, nadaLibre); ok {
+		t.Fatal("se encontró un punto libre con todo ocupado")
+	}
+}
+
+// nadaLibre rechaza cualquier lugar
+func nadaLibre(Rect) bool {
+	return false
+}
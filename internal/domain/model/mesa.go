@@ -22,10 +22,10 @@ func NewMesa(id int, x, y float64, paciencia time.Duration) *Mesa {
 	}
 }
 
-// AgregarClientes añade clientes a la mesa
-func (m *Mesa) AgregarClientes(cantidad int) {
+// AgregarClientes añade clientes a la mesa (ahora es el instante del reloj del juego)
+func (m *Mesa) AgregarClientes(cantidad int, ahora time.Time) {
 	if m.ClientesActivos == 0 {
-		m.TiempoEspera = time.Now()
+		m.TiempoEspera = ahora
 	}
 	m.ClientesActivos += cantidad
 }
@@ -42,19 +42,27 @@ func (m *Mesa) ClientesSatisfechos() {
 }
 
 // EstaPaciente verifica si los clientes siguen esperando
-func (m *Mesa) EstaPaciente() bool {
+func (m *Mesa) EstaPaciente(ahora time.Time) bool {
 	if m.ClientesActivos == 0 {
 		return true
 	}
-	return time.Since(m.TiempoEspera) < m.Paciencia
+	return m.Espera(ahora) < m.Paciencia
+}
+
+// Espera retorna cuánto tiempo llevan esperando los clientes actuales
+func (m *Mesa) Espera(ahora time.Time) time.Duration {
+	if m.ClientesActivos == 0 {
+		return 0
+	}
+	return ahora.Sub(m.TiempoEspera)
 }
 
 // GetNivelPaciencia retorna valor 0.0 a 1.0 (1.0 = muy impacientes)
-func (m *Mesa) GetNivelPaciencia() float64 {
+func (m *Mesa) GetNivelPaciencia(ahora time.Time) float64 {
 	if m.ClientesActivos == 0 {
 		return 0
 	}
-	elapsed := time.Since(m.TiempoEspera)
+	elapsed := m.Espera(ahora)
 	return float64(elapsed) / float64(m.Paciencia)
 }
 
@@ -69,13 +77,13 @@ type MesaSnapshot struct {
 
 // Snapshot crea una copia thread-safe de los datos de la mesa
 // DEBE ser llamado mientras se tiene el lock de mesasMu
-func (m *Mesa) Snapshot() MesaSnapshot {
+func (m *Mesa) Snapshot(ahora time.Time) MesaSnapshot {
 	return MesaSnapshot{
 		ID:              m.ID,
 		PosX:            m.PosX,
 		PosY:            m.PosY,
 		ClientesActivos: m.ClientesActivos,
 		TienePlato:      m.TienePlato,
-		NivelPaciencia:  m.GetNivelPaciencia(),
+		NivelPaciencia:  m.GetNivelPaciencia(ahora),
 	}
 }
@@ -12,31 +12,62 @@ const (
 	MeseroEntregando
 )
 
+// Tamaño del rectángulo de colisión del mesero
+const (
+	AnchoMesero = 32.0
+	AltoMesero  = 48.0
+)
+
+// CapacidadBandejaPorDefecto es cuántos platos lleva un mesero si el nivel no dice otra cosa
+// (uno, como antes de tener bandeja)
+const CapacidadBandejaPorDefecto = 1
+
+// PenalizacionPorPlato es cuánto más lento camina el mesero por cada plato extra en la bandeja
+// (llevar varios ahorra viajes pero los platos se enfrían y se llega más tarde)
+const PenalizacionPorPlato = 0.12
+
 // Mesero es el personaje controlable por el jugador
 type Mesero struct {
 	PosX, PosY       float64
 	VelocidadX       float64
 	VelocidadY       float64
 	Speed            float64
-	TienePlato       bool
-	PlatoEnMano      *Plato
+	Bandeja          []Plato // Platos en mano, en el orden en que se recogieron
+	CapacidadBandeja int     // Máximo de platos por viaje
 	Estado           EstadoMesero
 	UltimoMovimiento time.Time
+	Mapa             *Mapa     // Obstáculos y límites del salón (nil = sin colisiones)
+	Companeros       []*Mesero // Otros meseros con los que choca (co-op)
 }
 
 func NewMesero(x, y, speed float64) *Mesero {
 	return &Mesero{
-		PosX:   x,
-		PosY:   y,
-		Speed:  speed,
-		Estado: MeseroIdle,
+		PosX:             x,
+		PosY:             y,
+		Speed:            speed,
+		Estado:           MeseroIdle,
+		CapacidadBandeja: CapacidadBandejaPorDefecto,
 	}
 }
 
 // Mover actualiza la posición del mesero
+// El movimiento se resuelve por eje para deslizarse contra mesas, barra, bordes y otros meseros
 func (m *Mesero) Mover(dx, dy float64, deltaTime float64) {
-	m.PosX += dx * m.Speed * deltaTime
-	m.PosY += dy * m.Speed * deltaTime
+	// Si ya está dentro de un obstáculo (ej. al cambiar de nivel) pasa al lugar libre más
+	// cercano; si no hay ninguno espera quieto (nunca atraviesa obstáculos)
+	if !m.libre(m.boundsEn(m.PosX, m.PosY)) && !m.desatascar() {
+		dx, dy = 0, 0
+	}
+
+	velocidad := m.VelocidadEfectiva()
+	nuevoX := m.PosX + dx*velocidad*deltaTime
+	nuevoY := m.PosY + dy*velocidad*deltaTime
+	if m.libre(m.boundsEn(nuevoX, m.PosY)) {
+		m.PosX = nuevoX
+	}
+	if m.libre(m.boundsEn(m.PosX, nuevoY)) {
+		m.PosY = nuevoY
+	}
 
 	if dx != 0 || dy != 0 {
 		m.Estado = MeseroCaminando
@@ -48,23 +79,89 @@ func (m *Mesero) Mover(dx, dy float64, deltaTime float64) {
 	}
 }
 
-// RecogerPlato asigna un plato al mesero
-func (m *Mesero) RecogerPlato(plato Plato) {
-	m.TienePlato = true
-	m.PlatoEnMano = &plato
+// VelocidadEfectiva retorna la velocidad según cuántos platos lleva
+func (m *Mesero) VelocidadEfectiva() float64 {
+	extra := len(m.Bandeja) - 1
+	if extra <= 0 {
+		return m.Speed
+	}
+	return m.Speed * max(0.5, 1-PenalizacionPorPlato*float64(extra))
+}
+
+// LlevaPlatos indica si hay al menos un plato en la bandeja
+func (m *Mesero) LlevaPlatos() bool {
+	return len(m.Bandeja) > 0
+}
+
+// BandejaLlena indica si ya no puede recoger más platos
+func (m *Mesero) BandejaLlena() bool {
+	return len(m.Bandeja) >= m.CapacidadBandeja
+}
+
+// RecogerPlato agrega un plato a la bandeja
+// Retorna false si la bandeja está llena
+func (m *Mesero) RecogerPlato(plato Plato) bool {
+	if m.BandejaLlena() {
+		return false
+	}
+	m.Bandeja = append(m.Bandeja, plato)
 	m.Estado = MeseroRecogiendo
+	return true
+}
+
+// ProximoPlato retorna el plato que se entregaría ahora (el más antiguo de la bandeja)
+func (m *Mesero) ProximoPlato() (Plato, bool) {
+	if len(m.Bandeja) == 0 {
+		return Plato{}, false
+	}
+	return m.Bandeja[0], true
 }
 
-// EntregarPlato entrega el plato y limpia las manos
+// EntregarPlato saca de la bandeja el plato más antiguo
 func (m *Mesero) EntregarPlato() *Plato {
-	plato := m.PlatoEnMano
-	m.TienePlato = false
-	m.PlatoEnMano = nil
+	if len(m.Bandeja) == 0 {
+		return nil
+	}
+	plato := m.Bandeja[0]
+	m.Bandeja = m.Bandeja[1:]
 	m.Estado = MeseroEntregando
-	return plato
+	return &plato
 }
 
-// GetBounds retorna el rectángulo de colisión
+// GetBounds retorna el rectángulo de colisión (centrado en la posición, como el sprite)
 func (m *Mesero) GetBounds() (x, y, width, height float64) {
-	return m.PosX, m.PosY, 32, 48 // Ajusta según tu sprite
+	r := m.boundsEn(m.PosX, m.PosY)
+	return r.X, r.Y, r.W, r.H
+}
+
+// desatascar lleva al mesero que quedó encima de un obstáculo o de otro mesero a la celda
+// libre más cercana del mapa; retorna false si no hay mapa o ningún lugar libre
+func (m *Mesero) desatascar() bool {
+	if m.Mapa == nil {
+		return false
+	}
+	punto, ok := m.Mapa.PuntoLibreCercano(m.PosX, m.PosY, m.libre)
+	if !ok {
+		return false
+	}
+	m.PosX, m.PosY = punto.X, punto.Y
+	return true
+}
+
+// libre indica si el mesero puede ocupar el rectángulo r
+func (m *Mesero) libre(r Rect) bool {
+	if m.Mapa != nil && !m.Mapa.Libre(r) {
+		return false
+	}
+	for _, otro := range m.Companeros {
+		if otro != m && r.Intersecta(otro.boundsEn(otro.PosX, otro.PosY)) {
+			return false
+		}
+	}
+	return true
+}
+
+// boundsEn retorna el rectángulo de colisión si el mesero estuviera en (x, y)
+func (m *Mesero) boundsEn(x, y float64) Rect {
+	return Rect{X: x - AnchoMesero/2, Y: y - AltoMesero/2, W: AnchoMesero, H: AltoMesero}
 }
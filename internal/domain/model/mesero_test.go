@@ -0,0 +1,56 @@
+package model
+
+import "testing"
+
+// cuadroPorSegundo es el deltaTime de un paso de las pruebas de movimiento
+const cuadroPorSegundo = 1.0 / 60
+
+func TestMeseroMoverNoAtraviesaObstaculos(t *testing.T) {
+	casos := []struct {
+		nombre     string
+		obstaculos []Rect
+		x, y       float64
+		dx, dy     float64
+		quieto     bool // No hay lugar libre: debe esperar donde está
+	}{
+		{"choca contra la pared", []Rect{paredConPaso}, 100, 60, 1, 0, false},
+		{"se desliza por la pared", []Rect{paredConPaso}, 100, 60, 1, 1, false},
+		{"atascado dentro de la pared", []Rect{paredConPaso}, 160, 60, 1, 0, false},
+		{"atascado sin lugar libre", []Rect{{X: 0, Y: 0, W: ladoSalon, H: ladoSalon}}, 160, 60, 1, 0, true},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			m := NewMesero(caso.x, caso.y, 200)
+			m.Mapa = nuevoSalon(caso.obstaculos...)
+
+			for range 120 {
+				m.Mover(caso.dx, caso.dy, cuadroPorSegundo)
+				if caso.quieto {
+					if m.PosX != caso.x || m.PosY != caso.y {
+						t.Fatalf("sin lugar libre se movió a (%.0f, %.0f)", m.PosX, m.PosY)
+					}
+					continue
+				}
+				if !m.Mapa.Libre(m.boundsEn(m.PosX, m.PosY)) {
+					t.Fatalf("el mesero quedó dentro de un obstáculo en (%.0f, %.0f)", m.PosX, m.PosY)
+				}
+			}
+		})
+	}
+}
+
+func TestMeserosEncimadosSeSeparan(t *testing.T) {
+	mapa := nuevoSalon()
+	a, b := NewMesero(100, 100, 200), NewMesero(110, 100, 200)
+	meseros := []*Mesero{a, b}
+	for _, m := range meseros {
+		m.Mapa = mapa
+		m.Companeros = meseros
+	}
+
+	a.Mover(0, 0, cuadroPorSegundo)
+	b.Mover(0, 0, cuadroPorSegundo)
+	if a.boundsEn(a.PosX, a.PosY).Intersecta(b.boundsEn(b.PosX, b.PosY)) {
+		t.Fatalf("los meseros siguen encimados en (%.0f, %.0f) y (%.0f, %.0f)", a.PosX, a.PosY, b.PosX, b.PosY)
+	}
+}
@@ -0,0 +1,90 @@
+package model
+
+import "time"
+
+// PuntoLlegada es un punto de la curva de llegada de clientes:
+// a partir de Desde (tiempo del turno) la probabilidad de llegada tiende a Probabilidad
+type PuntoLlegada struct {
+	Desde        time.Duration
+	Probabilidad float64
+}
+
+// Nivel describe la dificultad de un turno: salón, cocina, clientes y metas
+type Nivel struct {
+	Numero int
+	Nombre string
+
+	// Salón
+	NumMesas  int
+	Paciencia time.Duration
+
+	// Cocina (productores y buffer)
+	NumCocineros     int
+	CapacidadBarra   int
+	CoccionBase      time.Duration
+	CoccionVariacion time.Duration
+	Etapas           []EtapaCocina  // Pipeline de la cocina; vacío = cada cocinero hace el plato entero
+	Equipos          []EquipoCocina // Equipos compartidos que piden recetas y etapas (hornos, freidoras)
+
+	// Despensa (ingredientes que consumen los cocineros y repone el proveedor)
+	Despensa ConfigDespensa
+
+	// Meseros (consumidores)
+	CapacidadBandeja int // Platos que un mesero lleva por viaje
+
+	// Llegada de clientes
+	IntervaloLlegada time.Duration
+	CurvaLlegada     []PuntoLlegada
+	Llegadas         PatronLlegada // Proceso de llegada (por defecto, la curva)
+
+	// Metas del turno
+	DuracionTurno time.Duration
+	MetaDinero    float64
+	MaxPerdidos   int
+}
+
+// NivelPorDefecto retorna los valores con los que el restaurante funcionaba sin niveles
+func NivelPorDefecto() Nivel {
+	return Nivel{
+		Numero:           1,
+		Nombre:           "Servicio libre",
+		NumMesas:         3,
+		Paciencia:        30 * time.Second,
+		NumCocineros:     1,
+		CapacidadBarra:   5,
+		CoccionBase:      1500 * time.Millisecond,
+		CoccionVariacion: 1000 * time.Millisecond,
+		CapacidadBandeja: CapacidadBandejaPorDefecto,
+		IntervaloLlegada: 5 * time.Second,
+		CurvaLlegada:     []PuntoLlegada{{Desde: 0, Probabilidad: 0.4}},
+		Llegadas:         PatronLlegada{Tipo: LlegadaCurva},
+		DuracionTurno:    3 * time.Minute,
+		MetaDinero:       300,
+		MaxPerdidos:      10,
+	}
+}
+
+// ProbabilidadLlegada interpola la curva de llegada para el tiempo transcurrido del turno
+func (n Nivel) ProbabilidadLlegada(transcurrido time.Duration) float64 {
+	curva := n.CurvaLlegada
+	if len(curva) == 0 {
+		return 0.4
+	}
+	if transcurrido <= curva[0].Desde {
+		return curva[0].Probabilidad
+	}
+
+	for i := 1; i < len(curva); i++ {
+		anterior, siguiente := curva[i-1], curva[i]
+		if transcurrido < siguiente.Desde {
+			tramo := float64(transcurrido-anterior.Desde) / float64(siguiente.Desde-anterior.Desde)
+			return anterior.Probabilidad + (siguiente.Probabilidad-anterior.Probabilidad)*tramo
+		}
+	}
+	return curva[len(curva)-1].Probabilidad
+}
+
+// NuevoTurno crea el turno con las metas del nivel
+func (n Nivel) NuevoTurno() *Turno {
+	return NewTurno(n.DuracionTurno, n.MetaDinero, n.MaxPerdidos)
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNivelPorDefecto(t *testing.T) {
+	nivel := NivelPorDefecto()
+
+	// Los archivos de nivel completan con estos valores lo que omiten
+	positivos := []struct {
+		nombre string
+		valor  int
+	}{
+		{"mesas", nivel.NumMesas},
+		{"cocineros", nivel.NumCocineros},
+		{"capacidad de la barra", nivel.CapacidadBarra},
+		{"capacidad de la bandeja", nivel.CapacidadBandeja},
+		{"máximo de perdidos", nivel.MaxPerdidos},
+	}
+	for _, campo := range positivos {
+		if campo.valor <= 0 {
+			t.Errorf("%s = %d, se esperaba positivo", campo.nombre, campo.valor)
+		}
+	}
+	if nivel.CapacidadBandeja != CapacidadBandejaPorDefecto {
+		t.Errorf("capacidad de la bandeja %d, se esperaba %d", nivel.CapacidadBandeja, CapacidadBandejaPorDefecto)
+	}
+	if nivel.Llegadas.Tipo != LlegadaCurva {
+		t.Errorf("llegadas %q, se esperaba la curva", nivel.Llegadas.Tipo)
+	}
+
+	turno := nivel.NuevoTurno()
+	if turno.Duracion != nivel.DuracionTurno || turno.MetaDinero != nivel.MetaDinero ||
+		turno.MaxPerdidos != nivel.MaxPerdidos || turno.Resultado != TurnoEnCurso {
+		t.Errorf("NuevoTurno no tomó las metas del nivel: %+v", turno)
+	}
+}
+
+func TestNivelProbabilidadLlegada(t *testing.T) {
+	curva := []PuntoLlegada{
+		{Desde: 10 * time.Second, Probabilidad: 0.2},
+		{Desde: 20 * time.Second, Probabilidad: 0.6},
+	}
+
+	casos := []struct {
+		nombre       string
+		curva        []PuntoLlegada
+		transcurrido time.Duration
+		probabilidad float64
+	}{
+		{"sin curva", nil, time.Minute, 0.4},
+		{"antes del primer punto", curva, 0, 0.2},
+		{"en el primer punto", curva, 10 * time.Second, 0.2},
+		{"a mitad de tramo", curva, 15 * time.Second, 0.4},
+		{"después del último punto", curva, time.Minute, 0.6},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			nivel := Nivel{CurvaLlegada: caso.curva}
+			if got := nivel.ProbabilidadLlegada(caso.transcurrido); math.Abs(got-caso.probabilidad) > 1e-9 {
+				t.Fatalf("ProbabilidadLlegada(%v) = %v, se esperaba %v", caso.transcurrido, got, caso.probabilidad)
+			}
+		})
+	}
+}
@@ -1,6 +1,13 @@
 package model
 
-import "time"
+import (
+	"sync/atomic"
+	"time"
+)
+
+// IDCocineroJugador identifica los platos que prepara el jugador en el rol de cocinero
+// (los cocineros automáticos se numeran desde 1)
+const IDCocineroJugador = 0
 
 type Plato struct {
 	ID         int
@@ -9,11 +16,32 @@ type Plato struct {
 	Timestamp  time.Time
 }
 
-func NewPlato(id, cocineroID int) Plato {
+func NewPlato(id, cocineroID int, ahora time.Time) Plato {
 	return Plato{
 		ID:         id,
 		Nombre:     "Plato especial",
 		CocineroID: cocineroID,
-		Timestamp:  time.Now(),
+		Timestamp:  ahora,
 	}
 }
+
+// Edad retorna cuánto tiempo lleva el plato desde que salió de cocina
+func (p Plato) Edad(ahora time.Time) time.Duration {
+	return ahora.Sub(p.Timestamp)
+}
+
+// NumeradorPlatos da a cada plato del turno un ID único, sin importar qué cocinero lo hizo
+// Es seguro para usar desde varias goroutines
+type NumeradorPlatos struct {
+	ultimo atomic.Int64
+}
+
+// Siguiente retorna el próximo ID (el primero es 1)
+func (n *NumeradorPlatos) Siguiente() int {
+	return int(n.ultimo.Add(1))
+}
+
+// Emitidos retorna cuántos IDs se entregaron
+func (n *NumeradorPlatos) Emitidos() int {
+	return int(n.ultimo.Load())
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"sync"
+	"testing"
+)
+
+// pedirIDs saca cantidad de IDs del numerador y los manda por el canal
+func pedirIDs(numerador *NumeradorPlatos, cantidad int, ids chan<- int, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for range cantidad {
+		ids <- numerador.Siguiente()
+	}
+}
+
+func TestNumeradorPlatosNoRepiteIDsEntreGoroutines(t *testing.T) {
+	const goroutines, porGoroutine = 8, 500
+	var numerador NumeradorPlatos
+	ids := make(chan int, goroutines*porGoroutine)
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for range goroutines {
+		go pedirIDs(&numerador, porGoroutine, ids, &wg)
+	}
+	wg.Wait()
+	close(ids)
+
+	vistos := make(map[int]bool)
+	for id := range ids {
+		if vistos[id] || id < 1 || id > goroutines*porGoroutine {
+			t.Fatalf("ID %d repetido o fuera de rango", id)
+		}
+		vistos[id] = true
+	}
+	if emitidos := numerador.Emitidos(); emitidos != goroutines*porGoroutine || len(vistos) != emitidos {
+		t.Fatalf("emitidos %d, IDs distintos %d", emitidos, len(vistos))
+	}
+}
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"sync"
+	"time"
+)
+
+// EstadoGoroutine es lo que está haciendo un cocinero o un mesero en este momento
+type EstadoGoroutine string
+
+const (
+	EstadoSinDemanda       EstadoGoroutine = "Sin demanda"       // Cocinero ocioso: nadie espera un plato
+	EstadoSinEntrada       EstadoGoroutine = "Sin entrada"       // Etapa esperando: la etapa anterior no le pasa platos
+	EstadoEsperandoRecurso EstadoGoroutine = "Esperando recurso" // Cocinero bloqueado en la cola de ingredientes o equipos
+	EstadoCocinando        EstadoGoroutine = "Cocinando"
+	EstadoBarraLlena       EstadoGoroutine = "Barra llena" // Productor bloqueado: la barra (o la cola siguiente) no tiene lugar
+	EstadoCaminando        EstadoGoroutine = "Caminando"
+	EstadoBarraVacia       EstadoGoroutine = "Barra vacia" // Consumidor esperando: no hay platos para recoger
+	EstadoLibre            EstadoGoroutine = "Libre"       // Mesero quieto sin esperar a la barra
+)
+
+// EstadosGoroutine son todos los estados, en el orden de la leyenda
+var EstadosGoroutine = []EstadoGoroutine{
+	EstadoSinDemanda, EstadoSinEntrada, EstadoEsperandoRecurso, EstadoCocinando, EstadoBarraLlena,
+	EstadoCaminando, EstadoBarraVacia, EstadoLibre,
+}
+
+// VentanaLineaTiempo es cuánto historial de estados se guarda por carril
+const VentanaLineaTiempo = 20 * time.Second
+
+// TramoEstado es un intervalo (en tiempo de juego) en el que se estuvo en un estado
+type TramoEstado struct {
+	Estado EstadoGoroutine
+	Desde  time.Time
+	Hasta  time.Time // En el último tramo, el instante del snapshot
+}
+
+// CarrilEstados es la historia reciente de un cocinero o mesero (un carril de la línea de tiempo)
+type CarrilEstados struct {
+	Quien  string
+	Actual EstadoGoroutine
+	Desde  time.Time // Desde cuándo está en el estado actual
+	Tramos []TramoEstado
+}
+
+// RegistroEstados guarda el estado de cada goroutine y sus cambios recientes
+// Es seguro para usar desde varias goroutines: los cocineros reportan desde las suyas
+// y los meseros desde el loop de la UI
+type RegistroEstados struct {
+	mu       sync.Mutex
+	reloj    *Reloj
+	carriles map[string]*CarrilEstados
+	orden    []string // Orden en que se registraron (cocina primero, salón después)
+}
+
+func NewRegistroEstados(reloj *Reloj) *RegistroEstados {
+	return &RegistroEstados{
+		reloj:    reloj,
+		carriles: make(map[string]*CarrilEstados),
+	}
+}
+
+// Reportar registra el estado actual de quien (si cambió, cierra el tramo anterior)
+// La primera vez que alguien reporta se le agrega un carril
+func (r *RegistroEstados) Reportar(quien string, estado EstadoGoroutine) {
+	ahora := r.reloj.Ahora()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	carril, ok := r.carriles[quien]
+	if !ok {
+		carril = &CarrilEstados{Quien: quien}
+		r.carriles[quien] = carril
+		r.orden = append(r.orden, quien)
+	} else if carril.Actual == estado {
+		return
+	}
+
+	if n := len(carril.Tramos); n > 0 {
+		carril.Tramos[n-1].Hasta = ahora
+	}
+	carril.Actual = estado
+	carril.Desde = ahora
+	carril.Tramos = append(carril.Tramos, TramoEstado{Estado: estado, Desde: ahora, Hasta: ahora})
+
+	// Descartar los tramos que ya salieron de la ventana
+	limite := ahora.Add(-VentanaLineaTiempo)
+	vencidos := 0
+	for vencidos < len(carril.Tramos)-1 && carril.Tramos[vencidos].Hasta.Before(limite) {
+		vencidos++
+	}
+	carril.Tramos = carril.Tramos[vencidos:]
+}
+
+// Estado retorna el estado actual de quien y desde cuándo (ok = false si nunca reportó)
+func (r *RegistroEstados) Estado(quien string) (estado EstadoGoroutine, desde time.Time, ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	carril, ok := r.carriles[quien]
+	if !ok {
+		return "", time.Time{}, false
+	}
+	return carril.Actual, carril.Desde, true
+}
+
+// Snapshot retorna una copia de los carriles en orden de registro, recortados a la ventana
+func (r *RegistroEstados) Snapshot() []CarrilEstados {
+	ahora := r.reloj.Ahora()
+	limite := ahora.Add(-VentanaLineaTiempo)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	carriles := make([]CarrilEstados, 0, len(r.orden))
+	for _, quien := range r.orden {
+		carril := *r.carriles[quien]
+		carril.Tramos = make([]TramoEstado, 0, len(r.carriles[quien].Tramos))
+		for _, tramo := range r.carriles[quien].Tramos {
+			if tramo.Hasta.Before(limite) {
+				continue
+			}
+			tramo.Desde = maxTiempo(tramo.Desde, limite)
+			carril.Tramos = append(carril.Tramos, tramo)
+		}
+		if n := len(carril.Tramos); n > 0 {
+			carril.Tramos[n-1].Hasta = ahora
+		}
+		carriles = append(carriles, carril)
+	}
+	return carriles
+}
+
+func maxTiempo(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// reporte es un paso de las pruebas del registro: avanza el reloj y reporta un estado
+type reporte struct {
+	avance time.Duration
+	estado EstadoGoroutine
+}
+
+func TestRegistroEstadosArmaLosTramos(t *testing.T) {
+	casos := []struct {
+		nombre   string
+		reportes []reporte
+		tramos   []EstadoGoroutine
+		desde    time.Duration // Desde cuándo está en el estado actual (desde el inicio)
+	}{
+		{
+			nombre:   "un estado",
+			reportes: []reporte{{0, EstadoCocinando}},
+			tramos:   []EstadoGoroutine{EstadoCocinando},
+		},
+		{
+			nombre:   "repetir el estado no abre otro tramo",
+			reportes: []reporte{{0, EstadoCocinando}, {time.Second, EstadoCocinando}},
+			tramos:   []EstadoGoroutine{EstadoCocinando},
+		},
+		{
+			nombre:   "cambiar de estado cierra el tramo anterior",
+			reportes: []reporte{{0, EstadoSinDemanda}, {time.Second, EstadoCocinando}, {time.Second, EstadoBarraLlena}},
+			tramos:   []EstadoGoroutine{EstadoSinDemanda, EstadoCocinando, EstadoBarraLlena},
+			desde:    2 * time.Second,
+		},
+		{
+			nombre: "los tramos viejos salen de la ventana",
+			reportes: []reporte{
+				{0, EstadoSinDemanda}, {time.Second, EstadoCocinando}, {time.Second, EstadoBarraLlena},
+				{VentanaLineaTiempo + time.Second, EstadoLibre},
+			},
+			tramos: []EstadoGoroutine{EstadoBarraLlena, EstadoLibre},
+			desde:  VentanaLineaTiempo + 3*time.Second,
+		},
+		{
+			nombre: "un estado largo sigue en la ventana",
+			reportes: []reporte{
+				{0, EstadoSinDemanda}, {2 * time.Second, EstadoCocinando},
+				{VentanaLineaTiempo + time.Second, EstadoBarraLlena},
+			},
+			tramos: []EstadoGoroutine{EstadoCocinando, EstadoBarraLlena},
+			desde:  VentanaLineaTiempo + 3*time.Second,
+		},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			reloj := NewRelojManual()
+			inicio := reloj.Ahora()
+			registro := NewRegistroEstados(reloj)
+			for _, r := range caso.reportes {
+				reloj.Avanzar(r.avance)
+				registro.Reportar("Cocinero 1", r.estado)
+			}
+
+			carriles := registro.Snapshot()
+			if len(carriles) != 1 {
+				t.Fatalf("se esperaba un carril, hay %d", len(carriles))
+			}
+			carril := carriles[0]
+			var tramos []EstadoGoroutine
+			for i, tramo := range carril.Tramos {
+				tramos = append(tramos, tramo.Estado)
+				if tramo.Hasta.Before(tramo.Desde) || (i > 0 && !tramo.Desde.Equal(carril.Tramos[i-1].Hasta)) {
+					t.Fatalf("tramos desparejos: %+v", carril.Tramos)
+				}
+			}
+			if !slices.Equal(tramos, caso.tramos) {
+				t.Fatalf("tramos %v, se esperaban %v", tramos, caso.tramos)
+			}
+			ultimo := caso.reportes[len(caso.reportes)-1].estado
+			if carril.Actual != ultimo || carril.Desde.Sub(inicio) != caso.desde {
+				t.Fatalf("actual %q desde %v, se esperaba %q desde %v",
+					carril.Actual, carril.Desde.Sub(inicio), ultimo, caso.desde)
+			}
+		})
+	}
+}
+
+func TestRegistroEstadosRespetaElOrdenDeRegistro(t *testing.T) {
+	registro := NewRegistroEstados(NewRelojManual())
+	for _, quien := range []string{"Cocinero 2", "Cocinero 1", "Mesero", "Cocinero 2"} {
+		registro.Reportar(quien, EstadoLibre)
+	}
+
+	var quienes []string
+	for _, carril := range registro.Snapshot() {
+		quienes = append(quienes, carril.Quien)
+	}
+	if esperados := []string{"Cocinero 2", "Cocinero 1", "Mesero"}; !slices.Equal(quienes, esperados) {
+		t.Fatalf("carriles %v, se esperaban %v", quienes, esperados)
+	}
+	if _, _, ok := registro.Estado("Nadie"); ok {
+		t.Fatal("hay estado para quien nunca reportó")
+	}
+}
@@ -0,0 +1,164 @@
+package model
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Reloj es el tiempo del juego: avanza como el reloj real pero se congela en pausa
+// Todas las esperas del juego (paciencia, llegadas, cocción, limpieza) lo usan para
+// que al reanudar continúen con el tiempo que les quedaba
+// Un reloj manual no sigue al reloj real: solo avanza cuando se llama a Avanzar (pruebas)
+type Reloj struct {
+	mu           sync.Mutex
+	pausado      bool
+	pausadoDesde time.Time     // Momento real en que empezó la pausa
+	enPausa      time.Duration // Tiempo real acumulado en pausas anteriores
+	reanudado    chan struct{} // Se cierra al reanudar para despertar a quienes esperan
+
+	// Reloj manual
+	manual  bool
+	actual  time.Time     // Momento "real" del reloj manual
+	avanzar chan struct{} // Se cierra en cada Avanzar para que las esperas vuelvan a mirar
+}
+
+func NewReloj() *Reloj {
+	return &Reloj{reanudado: make(chan struct{})}
+}
+
+// NewRelojManual crea un reloj que queda quieto hasta que se lo avanza con Avanzar
+func NewRelojManual() *Reloj {
+	return &Reloj{
+		reanudado: make(chan struct{}),
+		manual:    true,
+		actual:    time.Now(),
+		avanzar:   make(chan struct{}),
+	}
+}
+
+// Avanzar hace correr d al reloj manual y despierta a las esperas que ya cumplieron
+// (en pausa el tiempo del juego no avanza, igual que con el reloj real)
+func (r *Reloj) Avanzar(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.manual {
+		panic("Avanzar solo se puede usar con un reloj manual")
+	}
+	r.actual = r.actual.Add(d)
+	close(r.avanzar)
+	r.avanzar = make(chan struct{})
+}
+
+// Ahora retorna el instante actual del juego (no avanza durante la pausa)
+func (r *Reloj) Ahora() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ahora()
+}
+
+// ahora calcula el instante del juego
+// DEBE ser llamado mientras se tiene el lock de mu
+func (r *Reloj) ahora() time.Time {
+	if r.pausado {
+		return r.pausadoDesde.Add(-r.enPausa)
+	}
+	return r.real().Add(-r.enPausa)
+}
+
+// real retorna el momento real (o el del reloj manual)
+// DEBE ser llamado mientras se tiene el lock de mu
+func (r *Reloj) real() time.Time {
+	if r.manual {
+		return r.actual
+	}
+	return time.Now()
+}
+
+// Desde retorna el tiempo de juego transcurrido desde t
+func (r *Reloj) Desde(t time.Time) time.Duration {
+	return r.Ahora().Sub(t)
+}
+
+// Pausar congela el reloj
+func (r *Reloj) Pausar() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.pausado {
+		return
+	}
+	r.pausado = true
+	r.pausadoDesde = r.real()
+}
+
+// Reanudar vuelve a hacer avanzar el reloj y despierta a las esperas suspendidas
+func (r *Reloj) Reanudar() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.pausado {
+		return
+	}
+	r.enPausa += r.real().Sub(r.pausadoDesde)
+	r.pausado = false
+	close(r.reanudado)
+	r.reanudado = make(chan struct{})
+}
+
+// EstaPausado indica si el reloj está congelado
+func (r *Reloj) EstaPausado() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.pausado
+}
+
+// Esperar bloquea durante d de tiempo de juego (las pausas no cuentan)
+// Retorna false si el contexto se canceló antes, aunque d sea cero o negativo: así los
+// loops que esperan en cada vuelta terminan al cancelarse sin quedar girando
+func (r *Reloj) Esperar(ctx context.Context, d time.Duration) bool {
+	objetivo := r.Ahora().Add(d)
+
+	for {
+		if ctx.Err() != nil {
+			return false
+		}
+
+		r.mu.Lock()
+		pausado, reanudado, avanzar := r.pausado, r.reanudado, r.avanzar
+		restante := objetivo.Sub(r.ahora())
+		r.mu.Unlock()
+
+		if pausado {
+			select {
+			case <-reanudado:
+				continue
+			case <-ctx.Done():
+				return false
+			}
+		}
+		if restante <= 0 {
+			return true
+		}
+
+		// El reloj manual despierta cuando alguien lo avanza
+		if r.manual {
+			select {
+			case <-avanzar:
+				continue
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		// Si se pausa mientras tanto, al despertar se vuelve a calcular lo que falta
+		timer := time.NewTimer(restante)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		}
+	}
+}
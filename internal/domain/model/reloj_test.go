@@ -0,0 +1,81 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// esperarEnReloj espera d en el reloj y avisa por el canal si se cumplió
+func esperarEnReloj(reloj *Reloj, d time.Duration, listo chan<- bool) {
+	listo <- reloj.Esperar(context.Background(), d)
+}
+
+// sinAvisar verifica que la espera todavía no terminó
+func sinAvisar(t *testing.T, listo <-chan bool, momento string) {
+	t.Helper()
+	select {
+	case <-listo:
+		t.Fatalf("la espera terminó %s", momento)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestRelojManualSoloAvanzaAMano(t *testing.T) {
+	reloj := NewRelojManual()
+	inicio := reloj.Ahora()
+	listo := make(chan bool, 1)
+	go esperarEnReloj(reloj, time.Second, listo)
+
+	sinAvisar(t, listo, "sin avanzar el reloj")
+	reloj.Avanzar(600 * time.Millisecond)
+	sinAvisar(t, listo, "antes del segundo")
+
+	// En pausa el tiempo del juego no corre aunque se avance el reloj
+	reloj.Pausar()
+	reloj.Avanzar(time.Hour)
+	if transcurrido := reloj.Desde(inicio); transcurrido != 600*time.Millisecond {
+		t.Fatalf("en pausa el reloj marcó %v", transcurrido)
+	}
+	sinAvisar(t, listo, "en pausa")
+	reloj.Reanudar()
+
+	reloj.Avanzar(400 * time.Millisecond)
+	select {
+	case ok := <-listo:
+		if !ok {
+			t.Fatal("la espera se canceló")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("la espera no terminó al cumplirse el segundo")
+	}
+	if transcurrido := reloj.Desde(inicio); transcurrido != time.Second {
+		t.Fatalf("el reloj marcó %v, se esperaba 1s", transcurrido)
+	}
+}
+
+func TestRelojEsperarRespetaLaCancelacionSinEspera(t *testing.T) {
+	cancelado, cancelar := context.WithCancel(context.Background())
+	cancelar()
+
+	casos := []struct {
+		nombre string
+		reloj  *Reloj
+		ctx    context.Context
+		d      time.Duration
+		ok     bool
+	}{
+		{"cero", NewReloj(), context.Background(), 0, true},
+		{"negativa", NewReloj(), context.Background(), -time.Second, true},
+		{"cero cancelado", NewReloj(), cancelado, 0, false},
+		{"negativa cancelado", NewReloj(), cancelado, -time.Second, false},
+		{"manual cero cancelado", NewRelojManual(), cancelado, 0, false},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			if ok := caso.reloj.Esperar(caso.ctx, caso.d); ok != caso.ok {
+				t.Fatalf("Esperar(%v) = %v, se esperaba %v", caso.d, ok, caso.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package model
+
+import "time"
+
+const (
+	PrecioPlato    = 12.0             // Precio base por cliente servido
+	PropinaMaxima  = 0.25             // Propina máxima (fracción del consumo)
+	FrescuraIdeal  = 5 * time.Second  // Plato recién hecho: frescura completa
+	FrescuraMaxima = 20 * time.Second // A partir de aquí el plato está frío
+	pesoEspera     = 0.7              // Peso del tiempo de espera en la satisfacción
+	pesoFrescura   = 0.3              // Peso de la frescura del plato
+)
+
+// Entrega resume el resultado de servir un plato a una mesa
+type Entrega struct {
+	MesaID       int
+	PlatoID      int
+	Clientes     int
+	Satisfaccion float64 // 0.0 a 1.0 (1.0 = muy satisfechos)
+	Consumo      float64 // Ingreso por los platos servidos
+	Propina      float64 // Ingreso extra según satisfacción
+}
+
+// Total retorna el dinero ganado con la entrega
+func (e Entrega) Total() float64 {
+	return e.Consumo + e.Propina
+}
+
+// CalcularSatisfaccion combina la espera (relativa a la paciencia) y la frescura del plato
+func CalcularSatisfaccion(espera, paciencia, edadPlato time.Duration) float64 {
+	puntajeEspera := 1.0
+	if paciencia > 0 {
+		puntajeEspera = 1 - float64(espera)/float64(paciencia)
+	}
+
+	puntajeFrescura := 1.0
+	if edadPlato > FrescuraIdeal {
+		puntajeFrescura = 1 - float64(edadPlato-FrescuraIdeal)/float64(FrescuraMaxima-FrescuraIdeal)
+	}
+
+	return limitar(pesoEspera*limitar(puntajeEspera) + pesoFrescura*limitar(puntajeFrescura))
+}
+
+// NewEntrega calcula consumo y propina para una mesa servida
+func NewEntrega(mesaID, platoID, clientes int, satisfaccion float64) Entrega {
+	consumo := PrecioPlato * float64(clientes)
+	return Entrega{
+		MesaID:       mesaID,
+		PlatoID:      platoID,
+		Clientes:     clientes,
+		Satisfaccion: satisfaccion,
+		Consumo:      consumo,
+		Propina:      consumo * PropinaMaxima * satisfaccion,
+	}
+}
+
+// limitar restringe un valor al rango 0.0 a 1.0
+func limitar(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCalcularSatisfaccion(t *testing.T) {
+	paciencia := 30 * time.Second
+
+	casos := []struct {
+		nombre       string
+		espera       time.Duration
+		paciencia    time.Duration
+		edadPlato    time.Duration
+		satisfaccion float64
+	}{
+		{"servido al instante y fresco", 0, paciencia, 0, 1},
+		{"fresco hasta la frescura ideal", 0, paciencia, FrescuraIdeal, 1},
+		{"media paciencia", 15 * time.Second, paciencia, 0, 0.65},
+		{"plato a medio enfriar", 0, paciencia, 12500 * time.Millisecond, 0.85},
+		{"paciencia agotada", paciencia, paciencia, 0, 0.3},
+		{"plato frío", 0, paciencia, FrescuraMaxima, 0.7},
+		{"espera y frío más allá del límite", time.Minute, paciencia, time.Minute, 0},
+		{"sin paciencia configurada", time.Minute, 0, 0, 1},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			got := CalcularSatisfaccion(caso.espera, caso.paciencia, caso.edadPlato)
+			if math.Abs(got-caso.satisfaccion) > 1e-9 {
+				t.Fatalf("CalcularSatisfaccion = %v, se esperaba %v", got, caso.satisfaccion)
+			}
+		})
+	}
+}
+
+func TestNewEntregaPropina(t *testing.T) {
+	casos := []struct {
+		nombre       string
+		clientes     int
+		satisfaccion float64
+		propina      float64
+	}{
+		{"insatisfechos no dejan propina", 2, 0, 0},
+		{"medio satisfechos", 2, 0.5, 2 * PrecioPlato * PropinaMaxima / 2},
+		{"muy satisfechos dejan la propina máxima", 2, 1, 2 * PrecioPlato * PropinaMaxima},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			entrega := NewEntrega(1, 1, caso.clientes, caso.satisfaccion)
+			if consumo := PrecioPlato * float64(caso.clientes); entrega.Consumo != consumo {
+				t.Fatalf("consumo %v, se esperaba %v", entrega.Consumo, consumo)
+			}
+			if math.Abs(entrega.Propina-caso.propina) > 1e-9 {
+				t.Fatalf("propina %v, se esperaba %v", entrega.Propina, caso.propina)
+			}
+			if entrega.Total() != entrega.Consumo+entrega.Propina {
+				t.Fatalf("total %v no suma consumo y propina", entrega.Total())
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package model
+
+import "time"
+
+// ResultadoTurno representa cómo terminó (o si sigue) un turno de servicio
+type ResultadoTurno int
+
+const (
+	TurnoEnCurso  ResultadoTurno = iota
+	TurnoGanado                  // Se cumplió la meta de dinero al terminar el tiempo
+	TurnoPerdido                 // Se acabó el tiempo sin alcanzar la meta
+	TurnoGameOver                // Se fueron demasiados clientes
+)
+
+// Turno es un servicio con tiempo límite y condiciones de victoria/derrota
+type Turno struct {
+	Duracion     time.Duration
+	MetaDinero   float64
+	MaxPerdidos  int
+	Transcurrido time.Duration
+	Resultado    ResultadoTurno
+}
+
+func NewTurno(duracion time.Duration, metaDinero float64, maxPerdidos int) *Turno {
+	return &Turno{
+		Duracion:    duracion,
+		MetaDinero:  metaDinero,
+		MaxPerdidos: maxPerdidos,
+		Resultado:   TurnoEnCurso,
+	}
+}
+
+// Avanzar suma tiempo de juego al turno (no avanza si ya terminó)
+func (t *Turno) Avanzar(deltaTime float64) {
+	if t.Resultado != TurnoEnCurso {
+		return
+	}
+	t.Transcurrido += time.Duration(deltaTime * float64(time.Second))
+}
+
+// Evaluar actualiza y retorna el resultado según el dinero y los clientes perdidos
+func (t *Turno) Evaluar(dinero float64, perdidos int) ResultadoTurno {
+	if t.Resultado != TurnoEnCurso {
+		return t.Resultado
+	}
+
+	switch {
+	case t.MaxPerdidos > 0 && perdidos >= t.MaxPerdidos:
+		t.Resultado = TurnoGameOver
+	case t.Transcurrido >= t.Duracion && dinero >= t.MetaDinero:
+		t.Resultado = TurnoGanado
+	case t.Transcurrido >= t.Duracion:
+		t.Resultado = TurnoPerdido
+	}
+	return t.Resultado
+}
+
+// Restante retorna el tiempo que queda del turno
+func (t *Turno) Restante() time.Duration {
+	if t.Transcurrido >= t.Duracion {
+		return 0
+	}
+	return t.Duracion - t.Transcurrido
+}
+
+// Reiniciar vuelve el turno a su estado inicial
+func (t *Turno) Reiniciar() {
+	t.Transcurrido = 0
+	t.Resultado = TurnoEnCurso
+}
+
+// String retorna una descripción legible del resultado
+func (r ResultadoTurno) String() string {
+	switch r {
+	case TurnoGanado:
+		return "TURNO COMPLETADO"
+	case TurnoPerdido:
+		return "META NO ALCANZADA"
+	case TurnoGameOver:
+		return "GAME OVER - Demasiados clientes se fueron"
+	default:
+		return "En servicio"
+	}
+}
+
+// ResumenTurno es la foto final de métricas al terminar un turno
+type ResumenTurno struct {
+	Resultado    ResultadoTurno
+	Duracion     time.Duration
+	Producidos   int
+	Servidos     int
+	Perdidos     int
+	Dinero       float64
+	Propinas     float64
+	Satisfaccion float64
+}
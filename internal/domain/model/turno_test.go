@@ -0,0 +1,52 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTurnoEvaluar(t *testing.T) {
+	casos := []struct {
+		nombre       string
+		transcurrido time.Duration
+		dinero       float64
+		perdidos     int
+		maxPerdidos  int
+		resultado    ResultadoTurno
+	}{
+		{"en curso", 30 * time.Second, 0, 0, 5, TurnoEnCurso},
+		{"meta cumplida antes de tiempo sigue en curso", 30 * time.Second, 500, 0, 5, TurnoEnCurso},
+		{"ganado al terminar con la meta", time.Minute, 100, 2, 5, TurnoGanado},
+		{"perdido al terminar sin la meta", time.Minute, 99, 2, 5, TurnoPerdido},
+		{"game over al llegar al máximo de perdidos", 30 * time.Second, 500, 5, 5, TurnoGameOver},
+		{"game over gana aunque termine el tiempo con la meta", time.Minute, 500, 5, 5, TurnoGameOver},
+		{"sin máximo de perdidos no hay game over", time.Minute, 100, 50, 0, TurnoGanado},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			turno := NewTurno(time.Minute, 100, caso.maxPerdidos)
+			turno.Transcurrido = caso.transcurrido
+			if got := turno.Evaluar(caso.dinero, caso.perdidos); got != caso.resultado {
+				t.Fatalf("Evaluar = %v, se esperaba %v", got, caso.resultado)
+			}
+		})
+	}
+}
+
+func TestTurnoTerminadoNoCambia(t *testing.T) {
+	turno := NewTurno(time.Minute, 100, 5)
+	turno.Avanzar(60)
+	if got := turno.Evaluar(100, 0); got != TurnoGanado {
+		t.Fatalf("Evaluar = %v, se esperaba %v", got, TurnoGanado)
+	}
+
+	// Un turno terminado no avanza ni cambia de resultado hasta reiniciarlo
+	turno.Avanzar(10)
+	if got := turno.Evaluar(0, 10); got != TurnoGanado || turno.Restante() != 0 {
+		t.Fatalf("el turno terminado cambió: %v, restante %v", got, turno.Restante())
+	}
+	turno.Reiniciar()
+	if turno.Resultado != TurnoEnCurso || turno.Restante() != time.Minute {
+		t.Fatalf("Reiniciar dejó %v con %v restante", turno.Resultado, turno.Restante())
+	}
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"restaurant-concurrency/internal/domain/model"
+)
+
+// pasoReloj es cuánto avanza el reloj manual en cada paso de la prueba de estrés
+const pasoReloj = 20 * time.Millisecond
+
+// operacionesEstres es la cantidad de platos que los meseros deben recoger antes de cerrar
+const operacionesEstres = 3000
+
+// nivelEstres retorna un nivel con muchos cocineros y mesas, y una barra chica para que
+// los productores se bloqueen seguido
+func nivelEstres() model.Nivel {
+	nivel := model.NivelPorDefecto()
+	nivel.NumCocineros = 8
+	nivel.NumMesas = 16
+	nivel.CapacidadBarra = 4
+	nivel.CurvaLlegada = []model.PuntoLlegada{{Desde: 0, Probabilidad: 1}}
+	nivel.DuracionTurno = time.Hour
+	return nivel
+}
+
+// carga coordina el reloj manual y a los consumidores automáticos de la prueba de estrés
+type carga struct {
+	t           *testing.T
+	s           *RestaurantService
+	reloj       *model.Reloj
+	hasta       time.Time    // Límite real por si la carga no alcanza las operaciones
+	operaciones atomic.Int64 // Platos recogidos de la barra
+	ctx         context.Context
+	terminar    context.CancelFunc
+	wg          sync.WaitGroup
+}
+
+// terminada indica si ya se hicieron las operaciones pedidas o se venció el plazo
+func (c *carga) terminada() bool {
+	if c.ctx.Err() != nil {
+		return true
+	}
+	if c.operaciones.Load() >= operacionesEstres || time.Now().After(c.hasta) {
+		c.terminar()
+		return true
+	}
+	return false
+}
+
+// relojero avanza el reloj manual de a pasos: todo el tiempo del juego sale de aquí
+func (c *carga) relojero() {
+	defer c.wg.Done()
+	for !c.terminada() {
+		c.reloj.Avanzar(pasoReloj)
+		runtime.Gosched()
+	}
+}
+
+// mesero recoge platos de la barra y los entrega en la primera mesa que espera
+// Si no hay mesa esperando, el plato queda en mano hasta el cierre
+func (c *carga) mesero() {
+	defer c.wg.Done()
+	for !c.terminada() {
+		plato, ok := c.s.IntentarRecogerPlato()
+		if !ok {
+			runtime.Gosched()
+			continue
+		}
+		c.operaciones.Add(1)
+		for _, mesa := range c.s.GetMesas() {
+			if mesa.ClientesActivos > 0 && !mesa.TienePlato {
+				c.s.EntregarPlatoAMesa(*plato, mesa.PosX, mesa.PosY, 10)
+				break
+			}
+		}
+	}
+}
+
+// jugador deposita un plato cada tanto tiempo de juego, como el jugador en el rol de cocinero
+func (c *carga) jugador() {
+	defer c.wg.Done()
+	for c.reloj.Esperar(c.ctx, 500*time.Millisecond) {
+		c.s.DepositarPlato("")
+	}
+}
+
+// inspector revisa las invariantes mientras la carga está en curso
+func (c *carga) inspector() {
+	defer c.wg.Done()
+	capacidad := c.s.GetCapacidadBarra()
+	for !c.terminada() {
+		if enBarra := c.s.GetEstadoBarra(); enBarra > capacidad {
+			c.t.Errorf("la barra tiene %d platos con capacidad %d", enBarra, capacidad)
+			return
+		}
+		if reflejo := len(c.s.GetBarraSnapshot()); reflejo > capacidad {
+			c.t.Errorf("el reflejo de la barra tiene %d platos con capacidad %d", reflejo, capacidad)
+			return
+		}
+		if cuenta := c.s.GetContabilidad(); !cuadra(cuenta) {
+			c.t.Errorf("contabilidad inconsistente durante el turno: %+v", cuenta)
+			return
+		}
+		runtime.Gosched()
+	}
+}
+
+// cuadra verifica que cada plato producido esté en un solo lugar
+func cuadra(cuenta model.ContabilidadPlatos) bool {
+	return cuenta.Producidos == cuenta.EnBarra+cuenta.EnMano+cuenta.Entregados+cuenta.Desperdiciados &&
+		cuenta.Consistente()
+}
+
+func TestEstresInvariantesDelServicio(t *testing.T) {
+	antes := runtime.NumGoroutine()
+
+	reloj := model.NewRelojManual()
+	s := NewRestaurantServiceConReloj(nivelEstres(), reloj)
+	s.Start()
+
+	c := &carga{
+		t:     t,
+		s:     s,
+		reloj: reloj,
+		hasta: time.Now().Add(20 * time.Second),
+	}
+	c.ctx, c.terminar = context.WithCancel(context.Background())
+	c.wg.Add(10)
+	go c.relojero()
+	for range 6 {
+		go c.mesero()
+	}
+	for range 2 {
+		go c.jugador()
+	}
+	go c.inspector()
+	c.wg.Wait()
+
+	if n := c.operaciones.Load(); n < operacionesEstres {
+		t.Fatalf("la carga solo hizo %d operaciones de %d", n, operacionesEstres)
+	}
+	for _, cocinero := range s.GetCocineros() {
+		if cocinero.Producidos == 0 {
+			t.Errorf("%s no produjo ningún plato", cocinero.Nombre)
+		}
+	}
+
+	// Al cerrar, todo plato producido se entregó o se desperdició
+	reporte := s.Close()
+	cuenta := s.GetContabilidad()
+	if !cuadra(cuenta) || cuenta.EnBarra != 0 || cuenta.EnMano != 0 {
+		t.Fatalf("contabilidad inconsistente al cerrar: %+v", cuenta)
+	}
+	if cuenta.Desperdiciados != reporte.EnBarra+reporte.EnMano {
+		t.Fatalf("desperdiciados %d, el cierre tiró %d de la barra y %d en mano",
+			cuenta.Desperdiciados, reporte.EnBarra, reporte.EnMano)
+	}
+
+	esperarGoroutines(t, antes)
+}
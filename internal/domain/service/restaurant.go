@@ -6,75 +6,343 @@ import (
 	"restaurant-concurrency/internal/adapter/secondary/worker"
 	"restaurant-concurrency/internal/domain/model"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// PlazoCierre es el tiempo máximo que se espera para servir las mesas restantes al cerrar
+const PlazoCierre = 20 * time.Second
+
 type RestaurantService struct {
-	// Canal productor-consumidor (BUFFER)
+	// Canal productor-consumidor (BUFFER) y el reflejo de su contenido para la UI
 	barra          chan model.Plato
-	capacidadBarra int
+	contenidoBarra *model.ContenidoBarra
+	numerador      *model.NumeradorPlatos // IDs únicos para todos los platos del turno
+
+	// Nivel actual (dificultad: mesas, cocina, llegadas y metas)
+	nivel  model.Nivel
+	inicio time.Time // Inicio del turno (en tiempo de juego), para la curva de llegadas
+
+	// Reloj del juego: la pausa lo congela y con él todas las esperas
+	reloj *model.Reloj
 
 	// Mesas y clientes
 	mesas   []*model.Mesa
 	mesasMu sync.RWMutex
 
-	// Métricas
+	// Métricas (producidos y recogidos los cuenta el reflejo de la barra)
 	mu               sync.RWMutex
-	platosTotales    int
 	platosServidos   int
+	desechadosEnMano int // Platos en mano que se tiraron al cerrar
 	clientesPerdidos int
 	pausado          bool
+	platosJugador    int // Platos que el jugador (rol cocinero) dejó en la barra
+
+	// Cierre ordenado ("hora de cerrar")
+	cerrando    bool
+	plazoCierre time.Time
+
+	// Llegadas (para observar la carga generada)
+	gruposLlegados int
+	gruposSinMesa  int
+
+	// Economía (satisfacción y propinas)
+	dinero            float64
+	propinas          float64
+	satisfaccionTotal float64
+	entregas          int
 
 	// Concurrencia
 	ctx    context.Context
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
 
-	// Workers (adapters secundarios)
-	cocineros    []*worker.Cocinero
-	numCocineros int
+	// Workers (adapters secundarios): cocineros que hacen el plato entero
+	// o, si el nivel define etapas, el pipeline de la cocina
+	cocineros  []*worker.Cocinero
+	etapas     []*etapaPipeline
+	enPipeline *atomic.Int64 // Platos empezados por la primera etapa que no llegaron a la barra
+
+	// Despensa de ingredientes y su proveedor (nil si el nivel no la usa)
+	despensa  *model.Despensa
+	proveedor *worker.Proveedor
+
+	// Equipos compartidos de la cocina (nil si el nivel no los usa)
+	equipamiento *model.Equipamiento
+
+	// Escenario didáctico (interbloqueo, inanición): reemplaza a la cocina del nivel
+	// Se aplica en el próximo Reset o CargarNivel
+	escenario          model.Escenario
+	cocinerosEscenario []*worker.CocineroEscenario
+	reporteBloqueo     model.ReporteBloqueo // Última revisión del vigilante (protegido por mu)
+
+	// Estado de cada cocinero y mesero para la línea de tiempo (tiene su propio lock)
+	registro *model.RegistroEstados
+}
+
+// etapaPipeline es una etapa de la cocina con su canal de entrada y sus trabajadores
+type etapaPipeline struct {
+	config       model.EtapaCocina
+	entrada      chan model.Plato // nil en la primera etapa (arranca según la demanda)
+	trabajadores []*worker.TrabajadorEtapa
 }
 
 func NewRestaurantService(capacidadBarra, numCocineros, numMesas int) *RestaurantService {
+	nivel := model.NivelPorDefecto()
+	nivel.CapacidadBarra = capacidadBarra
+	nivel.NumCocineros = numCocineros
+	nivel.NumMesas = numMesas
+
+	return NewRestaurantServiceConNivel(nivel)
+}
+
+// NewRestaurantServiceConNivel crea el servicio con la configuración de un nivel
+func NewRestaurantServiceConNivel(nivel model.Nivel) *RestaurantService {
+	return NewRestaurantServiceConReloj(nivel, model.NewReloj())
+}
+
+// NewRestaurantServiceConReloj crea el servicio con un reloj dado (ej. uno manual para que
+// las pruebas avancen el tiempo del juego a mano); los reinicios siguen usando ese reloj
+func NewRestaurantServiceConReloj(nivel model.Nivel, reloj *model.Reloj) *RestaurantService {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	service := &RestaurantService{
-		barra:          make(chan model.Plato, capacidadBarra),
-		capacidadBarra: capacidadBarra,
-		numCocineros:   numCocineros,
-		ctx:            ctx,
-		cancel:         cancel,
-		mesas:          make([]*model.Mesa, 0, numMesas),
-		cocineros:      make([]*worker.Cocinero, 0, numCocineros),
+		nivel:  nivel,
+		reloj:  reloj,
+		ctx:    ctx,
+		cancel: cancel,
 	}
+	service.aplicarNivel()
+
+	return service
+}
 
-	// Crear cocineros (productores en el patrón Productor-Consumidor)
-	for i := 1; i <= numCocineros; i++ {
-		service.cocineros = append(service.cocineros, worker.NewCocinero(i))
+// aplicarNivel crea barra, cocineros y mesas según el nivel actual
+// Solo debe llamarse con las goroutines detenidas
+func (s *RestaurantService) aplicarNivel() {
+	s.barra = make(chan model.Plato, s.nivel.CapacidadBarra)
+	s.contenidoBarra = model.NewContenidoBarra()
+	s.numerador = &model.NumeradorPlatos{}
+	s.registro = model.NewRegistroEstados(s.reloj)
+
+	if s.escenario.Activo() {
+		// La cocina del escenario reemplaza a la del nivel (las mesas siguen siendo las del nivel)
+		s.crearEscenario()
+	} else {
+		// Crear la cocina (productores en el patrón Productor-Consumidor):
+		// un pipeline de etapas si el nivel lo define o cocineros que hacen el plato entero
+		s.cocinerosEscenario = nil
+		s.enPipeline = &atomic.Int64{}
+		s.etapas = crearEtapas(s.nivel.Etapas, s.reloj, s.enPipeline)
+		s.cocineros = nil
+		if len(s.etapas) == 0 {
+			s.cocineros = make([]*worker.Cocinero, 0, s.nivel.NumCocineros)
+			for i := 1; i <= s.nivel.NumCocineros; i++ {
+				s.cocineros = append(s.cocineros,
+					worker.NewCocinero(i, s.nivel.CoccionBase, s.nivel.CoccionVariacion, s.reloj))
+			}
+		}
+
+		// Despensa: primera capa productor-consumidor (proveedor -> cocina)
+		s.crearDespensa()
+
+		// Equipos compartidos (semáforos): los cocineros hacen cola por hornos y freidoras
+		s.crearEquipamiento()
 	}
 
+	// Cada worker de la cocina reporta su estado (los meseros lo hacen desde la UI)
+	s.conectarRegistro()
+
+	// Quienes empiezan platos los numeran con el mismo numerador y quienes depositan
+	// en la barra anotan cada plato en su reflejo
+	s.conectarNumerador()
+	s.conectarBarra()
+
 	// Crear mesas
+	s.mesasMu.Lock()
+	s.mesas = crearMesas(s.nivel.NumMesas, s.nivel.Paciencia)
+	s.mesasMu.Unlock()
+}
+
+// crearMesas crea las mesas en sus posiciones fijas del salón
+func crearMesas(numMesas int, paciencia time.Duration) []*model.Mesa {
 	positions := [][2]float64{
 		{100, 300}, {300, 300}, {500, 300}, {700, 300},
 		{100, 450}, {300, 450}, {500, 450}, {700, 450},
 	}
 
+	mesas := make([]*model.Mesa, 0, numMesas)
 	for i := 0; i < numMesas && i < len(positions); i++ {
-		mesa := model.NewMesa(i, positions[i][0], positions[i][1], 30*time.Second)
-		service.mesas = append(service.mesas, mesa)
+		mesa := model.NewMesa(i, positions[i][0], positions[i][1], paciencia)
+		mesas = append(mesas, mesa)
 	}
+	return mesas
+}
 
-	return service
+// crearDespensa crea la despensa llena y conecta a quienes empiezan los platos con ella:
+// los cocineros o, con pipeline, la primera etapa
+// Solo debe llamarse con las goroutines detenidas
+func (s *RestaurantService) crearDespensa() {
+	s.despensa, s.proveedor = nil, nil
+	config := s.nivel.Despensa
+	if !config.Activa() {
+		return
+	}
+
+	s.despensa = model.NewDespensa(config.Ingredientes)
+	if config.IntervaloReposicion > 0 {
+		s.proveedor = worker.NewProveedor(config.IntervaloReposicion, config.Reposicion, s.reloj)
+	}
+	for _, cocinero := range s.cocineros {
+		cocinero.UsarDespensa(s.despensa, config.Recetas)
+	}
+	if len(s.etapas) > 0 {
+		for _, trabajador := range s.etapas[0].trabajadores {
+			trabajador.UsarDespensa(s.despensa, config.Recetas)
+		}
+	}
+}
+
+// crearEquipamiento crea los equipos del nivel y se los da a todos los workers de la cocina
+// Solo debe llamarse con las goroutines detenidas
+func (s *RestaurantService) crearEquipamiento() {
+	s.equipamiento = nil
+	if len(s.nivel.Equipos) == 0 {
+		return
+	}
+
+	s.equipamiento = model.NewEquipamiento(s.nivel.Equipos)
+	for _, cocinero := range s.cocineros {
+		cocinero.UsarEquipos(s.equipamiento)
+	}
+	for _, etapa := range s.etapas {
+		for _, trabajador := range etapa.trabajadores {
+			trabajador.UsarEquipos(s.equipamiento)
+		}
+	}
+}
+
+// crearEscenario crea los cocineros y utensilios del escenario en lugar de la cocina del nivel
+// Los utensilios quedan como equipamiento para ver en la UI quién tiene cada uno y quién espera
+// Solo debe llamarse con las goroutines detenidas
+func (s *RestaurantService) crearEscenario() {
+	s.cocineros, s.etapas = nil, nil
+	s.despensa, s.proveedor = nil, nil
+	s.equipamiento = model.NewEquipamiento(s.escenario.Equipos())
+
+	configs := s.escenario.Cocineros()
+	s.cocinerosEscenario = make([]*worker.CocineroEscenario, 0, len(configs))
+	for i, config := range configs {
+		s.cocinerosEscenario = append(s.cocinerosEscenario,
+			worker.NewCocineroEscenario(i+1, config, s.equipamiento, s.reloj))
+	}
+}
+
+// conectarRegistro da el registro de estados a todos los workers de la cocina, en el orden
+// en que se muestran sus carriles
+// Solo debe llamarse con las goroutines detenidas
+func (s *RestaurantService) conectarRegistro() {
+	for _, cocinero := range s.cocineros {
+		cocinero.UsarRegistro(s.registro, cocinero.Nombre())
+	}
+	for _, etapa := range s.etapas {
+		for _, trabajador := range etapa.trabajadores {
+			trabajador.UsarRegistro(s.registro, trabajador.Nombre())
+		}
+	}
+	for _, cocinero := range s.cocinerosEscenario {
+		cocinero.UsarRegistro(s.registro, cocinero.Nombre())
+	}
+}
+
+// conectarNumerador da el numerador del turno a los workers que empiezan platos: los
+// cocineros, la primera etapa del pipeline o los cocineros del escenario
+// Solo debe llamarse con las goroutines detenidas
+func (s *RestaurantService) conectarNumerador() {
+	for _, cocinero := range s.cocineros {
+		cocinero.NumerarCon(s.numerador)
+	}
+	if len(s.etapas) > 0 {
+		for _, trabajador := range s.etapas[0].trabajadores {
+			trabajador.NumerarCon(s.numerador)
+		}
+	}
+	for _, cocinero := range s.cocinerosEscenario {
+		cocinero.NumerarCon(s.numerador)
+	}
+}
+
+// conectarBarra da el reflejo de la barra a los workers que depositan en ella: los cocineros,
+// la última etapa del pipeline o los cocineros del escenario
+// Solo debe llamarse con las goroutines detenidas
+func (s *RestaurantService) conectarBarra() {
+	for _, cocinero := range s.cocineros {
+		cocinero.AnotarEnBarra(s.contenidoBarra)
+	}
+	if len(s.etapas) > 0 {
+		for _, trabajador := range s.etapas[len(s.etapas)-1].trabajadores {
+			trabajador.AnotarEnBarra(s.contenidoBarra)
+		}
+	}
+	for _, cocinero := range s.cocinerosEscenario {
+		cocinero.AnotarEnBarra(s.contenidoBarra)
+	}
+}
+
+// crearEtapas crea las etapas del pipeline conectadas por canales acotados
+// Cada etapa tiene al menos un trabajador para que el pipeline no quede cortado
+// Todos los trabajadores comparten el contador de platos dentro del pipeline
+func crearEtapas(configs []model.EtapaCocina, reloj *model.Reloj, enPipeline *atomic.Int64) []*etapaPipeline {
+	etapas := make([]*etapaPipeline, 0, len(configs))
+	for i, config := range configs {
+		etapa := &etapaPipeline{config: config}
+		if i > 0 {
+			etapa.entrada = make(chan model.Plato, max(0, config.Cola))
+		}
+		for id := 1; id <= max(1, config.Trabajadores); id++ {
+			trabajador := worker.NewTrabajadorEtapa(id, config, reloj)
+			trabajador.ContarEnPipeline(enPipeline, i == len(configs)-1)
+			etapa.trabajadores = append(etapa.trabajadores, trabajador)
+		}
+		etapas = append(etapas, etapa)
+	}
+	return etapas
 }
 
 // Start inicia todas las goroutines
 func (s *RestaurantService) Start() {
+	s.inicio = s.reloj.Ahora()
+
 	// Iniciar cocineros
 	for _, cocinero := range s.cocineros {
 		s.wg.Add(1)
 		go s.ejecutarCocinero(cocinero)
 	}
 
+	// Iniciar los trabajadores de cada etapa del pipeline
+	for i, etapa := range s.etapas {
+		for _, trabajador := range etapa.trabajadores {
+			s.wg.Add(1)
+			go s.ejecutarEtapa(i, trabajador)
+		}
+	}
+
+	// Cocineros del escenario y el vigilante que detecta atascos
+	for _, cocinero := range s.cocinerosEscenario {
+		s.wg.Add(1)
+		go s.ejecutarCocineroEscenario(cocinero)
+	}
+	if s.escenario.Activo() {
+		s.wg.Add(1)
+		go s.vigilante()
+	}
+
+	// Proveedor de la despensa
+	if s.proveedor != nil {
+		s.wg.Add(1)
+		go s.ejecutarProveedor()
+	}
+
 	// Generador de clientes
 	s.wg.Add(1)
 	go s.generadorClientes()
@@ -90,18 +358,52 @@ func (s *RestaurantService) ejecutarCocinero(cocinero *worker.Cocinero) {
 	cocinero.Producir(s.ctx, s.barra, s.hayDemanda)
 }
 
+// ejecutarEtapa conecta a un trabajador con la entrada de su etapa y la salida hacia
+// la etapa siguiente (la última etapa entrega en la barra)
+func (s *RestaurantService) ejecutarEtapa(indice int, trabajador *worker.TrabajadorEtapa) {
+	defer s.wg.Done()
+
+	salida := s.barra
+	if indice+1 < len(s.etapas) {
+		salida = s.etapas[indice+1].entrada
+	}
+
+	if indice == 0 {
+		trabajador.Iniciar(s.ctx, salida, s.hayDemanda)
+		return
+	}
+	trabajador.Procesar(s.ctx, s.etapas[indice].entrada, salida)
+}
+
+// ejecutarCocineroEscenario es un método helper para evitar función anónima
+func (s *RestaurantService) ejecutarCocineroEscenario(cocinero *worker.CocineroEscenario) {
+	defer s.wg.Done()
+	cocinero.Producir(s.ctx, s.barra, s.hayDemanda)
+}
+
+// ejecutarProveedor es un método helper para evitar función anónima
+func (s *RestaurantService) ejecutarProveedor() {
+	defer s.wg.Done()
+	s.proveedor.Abastecer(s.ctx, s.despensa)
+}
+
 // hayDemanda verifica si hay clientes esperando (para que cocineros produzcan)
+// En pausa o durante el cierre no se empiezan platos nuevos
 func (s *RestaurantService) hayDemanda() bool {
-	s.mesasMu.RLock()
-	defer s.mesasMu.RUnlock()
-
 	s.mu.RLock()
-	pausado := s.pausado
+	detenido := s.pausado || s.cerrando
 	s.mu.RUnlock()
 
-	if pausado {
+	if detenido {
 		return false
 	}
+	return s.hayMesasEsperando()
+}
+
+// hayMesasEsperando indica si alguna mesa con clientes todavía no recibió su plato
+func (s *RestaurantService) hayMesasEsperando() bool {
+	s.mesasMu.RLock()
+	defer s.mesasMu.RUnlock()
 
 	for _, mesa := range s.mesas {
 		if mesa.ClientesActivos > 0 && !mesa.TienePlato {
@@ -111,51 +413,112 @@ func (s *RestaurantService) hayDemanda() bool {
 	return false
 }
 
+// vigilante revisa periódicamente a los cocineros del escenario (WATCHDOG): reporta a los que
+// esperan un utensilio hace más de UmbralAtasco y busca un ciclo en el grafo de esperas
+// (A espera lo que tiene B y B espera lo que tiene A = interbloqueo)
+func (s *RestaurantService) vigilante() {
+	defer s.wg.Done()
+
+	for s.reloj.Esperar(s.ctx, 500*time.Millisecond) {
+		reporte := s.revisarAtascos()
+
+		s.mu.Lock()
+		s.reporteBloqueo = reporte
+		s.mu.Unlock()
+	}
+}
+
+// revisarAtascos arma el reporte del vigilante con el estado actual de los cocineros
+func (s *RestaurantService) revisarAtascos() model.ReporteBloqueo {
+	var reporte model.ReporteBloqueo
+	ahora := s.reloj.Ahora()
+	esperas := make(map[string]string)
+	titulares := make(map[string][]string)
+
+	for _, cocinero := range s.cocinerosEscenario {
+		recurso, desde, ok := cocinero.Esperando()
+		if !ok || ahora.Sub(desde) < model.UmbralAtasco {
+			continue
+		}
+		reporte.Atascados = append(reporte.Atascados, model.Atasco{
+			Quien:   cocinero.Nombre(),
+			Recurso: recurso,
+			Espera:  ahora.Sub(desde),
+		})
+		esperas[cocinero.Nombre()] = recurso
+		if equipo := s.equipamiento.Equipo(recurso); equipo != nil {
+			titulares[recurso] = equipo.Titulares()
+		}
+	}
+
+	reporte.Ciclo = model.BuscarCiclo(esperas, titulares)
+	return reporte
+}
+
+// generadorClientes hace llegar clientes según el proceso de llegada del nivel
 func (s *RestaurantService) generadorClientes() {
 	defer s.wg.Done()
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
+	proceso := s.nivel.NuevoProcesoLlegada()
 
 	for {
-		select {
-		case <-s.ctx.Done():
+		// Esperar hasta la próxima llegada (Poisson, programada, ráfagas o curva)
+		// con el reloj del juego: en pausa no llega nadie
+		if !s.reloj.Esperar(s.ctx, proceso.Espera(s.reloj.Desde(s.inicio))) {
 			return
-		case <-ticker.C:
-			// Agregar clientes aleatoriamente a mesas vacías
-			s.mesasMu.Lock()
-			for _, mesa := range s.mesas {
-				if mesa.ClientesActivos == 0 && rand.Float64() < 0.4 {
-					cantidadClientes := rand.Intn(3) + 1
-					mesa.AgregarClientes(cantidadClientes)
-				}
-			}
-			s.mesasMu.Unlock()
 		}
+		s.sentarClientes(proceso)
 	}
 }
 
+// sentarClientes asigna los grupos que llegan a mesas libres elegidas al azar
+// Los grupos que no encuentran mesa se van sin sentarse
+func (s *RestaurantService) sentarClientes(proceso model.ProcesoLlegada) {
+	// Durante el cierre no se sientan clientes nuevos
+	if cerrando, _ := s.GetCierre(); cerrando {
+		return
+	}
+
+	s.mesasMu.Lock()
+	defer s.mesasMu.Unlock()
+
+	libres := make([]*model.Mesa, 0, len(s.mesas))
+	for _, mesa := range s.mesas {
+		if mesa.ClientesActivos == 0 {
+			libres = append(libres, mesa)
+		}
+	}
+
+	ahora := s.reloj.Ahora()
+	grupos := proceso.Grupos(ahora.Sub(s.inicio), len(libres))
+	sentados := min(grupos, len(libres))
+
+	for _, i := range rand.Perm(len(libres))[:sentados] {
+		libres[i].AgregarClientes(s.nivel.Llegadas.TamanoGrupo(), ahora)
+	}
+
+	s.mu.Lock()
+	s.gruposLlegados += grupos
+	s.gruposSinMesa += grupos - sentados
+	s.mu.Unlock()
+}
+
 func (s *RestaurantService) verificadorPaciencia() {
 	defer s.wg.Done()
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
 
-	for {
-		select {
-		case <-s.ctx.Done():
-			return
-		case <-ticker.C:
-			s.mesasMu.Lock()
-			for _, mesa := range s.mesas {
-				if mesa.ClientesActivos > 0 && !mesa.EstaPaciente() {
-					// Clientes se fueron por falta de servicio
-					s.mu.Lock()
-					s.clientesPerdidos += mesa.ClientesActivos
-					s.mu.Unlock()
-					mesa.ClientesSatisfechos()
-				}
+	// La paciencia se mide con el reloj del juego: en pausa nadie se va
+	for s.reloj.Esperar(s.ctx, 1*time.Second) {
+		ahora := s.reloj.Ahora()
+		s.mesasMu.Lock()
+		for _, mesa := range s.mesas {
+			if mesa.ClientesActivos > 0 && !mesa.EstaPaciente(ahora) {
+				// Clientes se fueron por falta de servicio
+				s.mu.Lock()
+				s.clientesPerdidos += mesa.ClientesActivos
+				s.mu.Unlock()
+				mesa.ClientesSatisfechos()
 			}
-			s.mesasMu.Unlock()
 		}
+		s.mesasMu.Unlock()
 	}
 }
 
@@ -163,17 +526,46 @@ func (s *RestaurantService) verificadorPaciencia() {
 func (s *RestaurantService) IntentarRecogerPlato() (*model.Plato, bool) {
 	select {
 	case plato := <-s.barra:
-		s.mu.Lock()
-		s.platosTotales++
-		s.mu.Unlock()
+		s.contenidoBarra.Quitar(plato)
 		return &plato, true
 	default:
 		return nil, false
 	}
 }
 
-// EntregarPlatoAMesa entrega un plato a una mesa cercana
-func (s *RestaurantService) EntregarPlatoAMesa(meseroX, meseroY float64, rango float64) bool {
+// TomarIngredientes permite al jugador en el rol de cocinero tomar los ingredientes de una receta
+// No se bloquea: retorna false si la despensa no alcanza (sin despensa siempre alcanza)
+func (s *RestaurantService) TomarIngredientes() (receta string, ok bool) {
+	if s.despensa == nil {
+		return "", true
+	}
+	tomada, ok := s.despensa.IntentarTomar(s.nivel.Despensa.Recetas)
+	return tomada.Nombre, ok
+}
+
+// DepositarPlato permite al jugador en el rol de cocinero PRODUCIR en la barra
+// A diferencia de los cocineros automáticos no se bloquea: si la barra está llena
+// retorna false y el plato queda esperando en la estación
+func (s *RestaurantService) DepositarPlato(receta string) (model.Plato, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	plato := model.NewPlato(s.numerador.Siguiente(), model.IDCocineroJugador, s.reloj.Ahora())
+	if receta != "" {
+		plato.Nombre = receta
+	}
+	select {
+	case s.barra <- plato:
+		s.contenidoBarra.Agregar(plato)
+		s.platosJugador++
+		return plato, true
+	default:
+		return model.Plato{}, false
+	}
+}
+
+// EntregarPlatoAMesa entrega un plato a una mesa cercana y calcula la satisfacción
+func (s *RestaurantService) EntregarPlatoAMesa(plato model.Plato, meseroX, meseroY float64, rango float64) (model.Entrega, bool) {
 	s.mesasMu.Lock()
 	defer s.mesasMu.Unlock()
 
@@ -185,19 +577,35 @@ func (s *RestaurantService) EntregarPlatoAMesa(meseroX, meseroY float64, rango f
 			distancia := dx*dx + dy*dy
 
 			if distancia < rango*rango {
+				ahora := s.reloj.Ahora()
+				satisfaccion := model.CalcularSatisfaccion(mesa.Espera(ahora), mesa.Paciencia, plato.Edad(ahora))
+				entrega := model.NewEntrega(mesa.ID, plato.ID, mesa.ClientesActivos, satisfaccion)
 				mesa.EntregarPlato()
+
 				s.mu.Lock()
 				s.platosServidos++
+				s.registrarEntrega(entrega)
 				s.mu.Unlock()
 
 				// Después de un tiempo, clientes se van satisfechos
-				go s.limpiarMesaDespuesDeTiempo(mesa, 3*time.Second)
+				// (registrada en el WaitGroup para que Detener/Reset no la dejen huérfana)
+				s.wg.Add(1)
+				go s.limpiarMesaDespuesDeTiempo(s.ctx, mesa, 3*time.Second)
 
-				return true
+				return entrega, true
 			}
 		}
 	}
-	return false
+	return model.Entrega{}, false
+}
+
+// registrarEntrega acumula dinero y satisfacción
+// DEBE ser llamado mientras se tiene el lock de mu
+func (s *RestaurantService) registrarEntrega(entrega model.Entrega) {
+	s.dinero += entrega.Total()
+	s.propinas += entrega.Propina
+	s.satisfaccionTotal += entrega.Satisfaccion
+	s.entregas++
 }
 
 // GetMesas retorna snapshots inmutables de las mesas (thread-safe para rendering)
@@ -205,9 +613,10 @@ func (s *RestaurantService) GetMesas() []model.MesaSnapshot {
 	s.mesasMu.RLock()
 	defer s.mesasMu.RUnlock()
 
+	ahora := s.reloj.Ahora()
 	snapshots := make([]model.MesaSnapshot, len(s.mesas))
 	for i, mesa := range s.mesas {
-		snapshots[i] = mesa.Snapshot()
+		snapshots[i] = mesa.Snapshot(ahora)
 	}
 	return snapshots
 }
@@ -216,38 +625,387 @@ func (s *RestaurantService) GetEstadoBarra() int {
 	return len(s.barra)
 }
 
+// GetBarraSnapshot retorna los platos de la barra en orden de llegada, con su identidad y edad
+// El primero es el que se llevará la próxima recogida
+func (s *RestaurantService) GetBarraSnapshot() []model.PlatoEnBarra {
+	return s.contenidoBarra.Snapshot(s.reloj.Ahora())
+}
+
 func (s *RestaurantService) GetCapacidadBarra() int {
-	return s.capacidadBarra
+	return s.nivel.CapacidadBarra
+}
+
+// GetMetricas retorna los platos que llegaron a la barra, los servidos y los clientes perdidos
+func (s *RestaurantService) GetMetricas() (producidos, servidos, perdidos int) {
+	s.mu.RLock()
+	servidos, perdidos = s.platosServidos, s.clientesPerdidos
+	s.mu.RUnlock()
+
+	return s.contenidoBarra.Contabilidad().Producidos, servidos, perdidos
+}
+
+// GetContabilidad retorna qué pasó con los platos del turno: producidos, en la barra,
+// recogidos, entregados, en mano y desperdiciados
+func (s *RestaurantService) GetContabilidad() model.ContabilidadPlatos {
+	// Primero lo entregado y después la barra: como no se entrega un plato sin recogerlo,
+	// en este orden nunca se cuenta un entregado que todavía no figure como recogido
+	s.mu.RLock()
+	entregados, desechadosEnMano := s.platosServidos, s.desechadosEnMano
+	s.mu.RUnlock()
+
+	contabilidad := s.contenidoBarra.Contabilidad()
+	contabilidad.Entregados = entregados
+	contabilidad.EnMano = contabilidad.Recogidos - entregados - desechadosEnMano
+	contabilidad.Desperdiciados += desechadosEnMano
+	return contabilidad
+}
+
+// GetLlegadas retorna los grupos que llegaron y cuántos se fueron por no encontrar mesa
+func (s *RestaurantService) GetLlegadas() (llegados, sinMesa int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.gruposLlegados, s.gruposSinMesa
+}
+
+// GetEconomia retorna el dinero acumulado, las propinas y la satisfacción promedio (0.0 a 1.0)
+func (s *RestaurantService) GetEconomia() (dinero, propinas, satisfaccionPromedio float64) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.entregas > 0 {
+		satisfaccionPromedio = s.satisfaccionTotal / float64(s.entregas)
+	}
+	return s.dinero, s.propinas, satisfaccionPromedio
+}
+
+// GetEtapas retorna el estado de cada etapa del pipeline (vacío si la cocina no tiene etapas)
+func (s *RestaurantService) GetEtapas() []model.EtapaSnapshot {
+	etapas := make([]model.EtapaSnapshot, 0, len(s.etapas))
+	for _, etapa := range s.etapas {
+		snapshot := model.EtapaSnapshot{
+			Nombre:        etapa.config.Nombre,
+			Trabajadores:  len(etapa.trabajadores),
+			EnCola:        len(etapa.entrada),
+			CapacidadCola: cap(etapa.entrada),
+		}
+		for _, trabajador := range etapa.trabajadores {
+			if trabajador.EstaOcupado() {
+				snapshot.Ocupados++
+			}
+			snapshot.Procesados += trabajador.PlatosProcesados()
+		}
+		etapas = append(etapas, snapshot)
+	}
+	return etapas
+}
+
+// GetDespensa retorna el stock de cada ingrediente y cuántos cocineros esperan ingredientes
+// (sin despensa retorna nil)
+func (s *RestaurantService) GetDespensa() (ingredientes []model.IngredienteSnapshot, esperando int) {
+	if s.despensa == nil {
+		return nil, 0
+	}
+	for _, cocinero := range s.cocineros {
+		if cocinero.EsperandoIngredientes() {
+			esperando++
+		}
+	}
+	if len(s.etapas) > 0 {
+		for _, trabajador := range s.etapas[0].trabajadores {
+			if trabajador.EsperandoIngredientes() {
+				esperando++
+			}
+		}
+	}
+	return s.despensa.Snapshot(), esperando
+}
+
+// GetEquipos retorna quién usa y quién espera cada equipo compartido (nil sin equipos)
+func (s *RestaurantService) GetEquipos() []model.EquipoSnapshot {
+	if s.equipamiento == nil {
+		return nil
+	}
+	return s.equipamiento.Snapshot()
 }
 
-func (s *RestaurantService) GetMetricas() (totales, servidos, perdidos int) {
+// SetEscenario elige el escenario didáctico; se aplica en el próximo Reset o CargarNivel
+func (s *RestaurantService) SetEscenario(escenario model.Escenario) {
+	s.escenario = escenario
+}
+
+// GetEscenario retorna el escenario elegido
+func (s *RestaurantService) GetEscenario() model.Escenario {
+	return s.escenario
+}
+
+// GetReporteBloqueo retorna la última revisión del vigilante (vacía sin escenario)
+func (s *RestaurantService) GetReporteBloqueo() model.ReporteBloqueo {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return s.platosTotales, s.platosServidos, s.clientesPerdidos
+	return s.reporteBloqueo
+}
+
+// GetCocineros retorna el estado de cada cocinero que hace el plato entero
+// (vacío si la cocina es un pipeline o un escenario)
+func (s *RestaurantService) GetCocineros() []model.CocineroSnapshot {
+	ahora := s.reloj.Ahora()
+	cocineros := make([]model.CocineroSnapshot, 0, len(s.cocineros))
+	for _, cocinero := range s.cocineros {
+		cocineros = append(cocineros, cocinero.Snapshot(ahora))
+	}
+	return cocineros
 }
 
+// GetBloqueosBarra retorna, por cada cocinero que deja platos en la barra, si está bloqueado
+// porque la barra está llena, con qué plato y cuánto tiempo acumula bloqueado
+// (con pipeline, los cocineros son los trabajadores de la última etapa)
+func (s *RestaurantService) GetBloqueosBarra() []model.BloqueoProductor {
+	ahora := s.reloj.Ahora()
+	bloqueos := make([]model.BloqueoProductor, 0, len(s.cocineros))
+
+	for _, cocinero := range s.cocineros {
+		bloqueos = append(bloqueos, conNombre(cocinero.BloqueoSalida(ahora), cocinero.Nombre()))
+	}
+	if len(s.etapas) > 0 {
+		for _, trabajador := range s.etapas[len(s.etapas)-1].trabajadores {
+			bloqueos = append(bloqueos, conNombre(trabajador.BloqueoSalida(ahora), trabajador.Nombre()))
+		}
+	}
+	for _, cocinero := range s.cocinerosEscenario {
+		bloqueos = append(bloqueos, conNombre(cocinero.BloqueoSalida(ahora), cocinero.Nombre()))
+	}
+	return bloqueos
+}
+
+func conNombre(bloqueo model.BloqueoProductor, cocinero string) model.BloqueoProductor {
+	bloqueo.Cocinero = cocinero
+	return bloqueo
+}
+
+// ReportarEstado registra lo que hace un mesero (los meseros viven en la UI, no en goroutines propias)
+func (s *RestaurantService) ReportarEstado(quien string, estado model.EstadoGoroutine) {
+	s.registro.Reportar(quien, estado)
+}
+
+// GetLineaTiempo retorna la historia reciente de estados de cada cocinero y mesero
+func (s *RestaurantService) GetLineaTiempo() []model.CarrilEstados {
+	return s.registro.Snapshot()
+}
+
+// Ahora retorna el instante actual del reloj del juego (para calcular la edad de los platos en mano)
+func (s *RestaurantService) Ahora() time.Time {
+	return s.reloj.Ahora()
+}
+
+// TogglePausar pausa o reanuda el restaurante: además de detener la producción congela
+// el reloj del juego, así que llegadas, paciencia, cocción y limpieza de mesas se suspenden
+// y al reanudar continúan con el tiempo que les quedaba
 func (s *RestaurantService) TogglePausar() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.pausado = !s.pausado
+
+	if s.pausado {
+		s.reloj.Pausar()
+	} else {
+		s.reloj.Reanudar()
+	}
 }
 
-// limpiarMesaDespuesDeTiempo limpia la mesa después de un tiempo especificado
-// Usa time.After con select en lugar de time.Sleep para respetar cancelación
-func (s *RestaurantService) limpiarMesaDespuesDeTiempo(mesa *model.Mesa, duracion time.Duration) {
-	select {
-	case <-time.After(duracion):
-		s.mesasMu.Lock()
-		mesa.ClientesSatisfechos()
-		s.mesasMu.Unlock()
-	case <-s.ctx.Done():
-		// Si se cancela el contexto, no limpiar la mesa
+// Pausar pone al restaurante en pausa; retorna false si ya estaba en pausa
+func (s *RestaurantService) Pausar() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pausado {
+		return false
+	}
+	s.pausado = true
+	s.reloj.Pausar()
+	return true
+}
+
+// Reanudar saca al restaurante de la pausa (si no estaba en pausa no hace nada)
+func (s *RestaurantService) Reanudar() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pausado {
+		s.pausado = false
+		s.reloj.Reanudar()
+	}
+}
+
+// EstaPausado indica si el restaurante está en pausa
+func (s *RestaurantService) EstaPausado() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.pausado
+}
+
+// limpiarMesaDespuesDeTiempo limpia la mesa después de un tiempo de juego especificado
+// Usa el reloj del juego para respetar la pausa y la cancelación
+// Recibe el contexto vigente al entregar para no leer s.ctx durante un Reset
+func (s *RestaurantService) limpiarMesaDespuesDeTiempo(ctx context.Context, mesa *model.Mesa, duracion time.Duration) {
+	defer s.wg.Done()
+
+	// Si se cancela el contexto, no limpiar la mesa
+	if !s.reloj.Esperar(ctx, duracion) {
 		return
 	}
+
+	s.mesasMu.Lock()
+	mesa.ClientesSatisfechos()
+	s.mesasMu.Unlock()
 }
 
-func (s *RestaurantService) Close() {
+// Detener cancela el contexto y espera a que terminen las goroutines (sin cerrar la barra)
+func (s *RestaurantService) Detener() {
 	s.cancel()
 	s.wg.Wait()
+}
+
+// Reset detiene las goroutines, limpia barra, mesas y métricas, y vuelve a arrancar
+// el servicio con un contexto nuevo (usado para reiniciar un turno sin reiniciar el proceso)
+func (s *RestaurantService) Reset() {
+	s.Detener()
+	s.reiniciar()
+}
+
+// CargarNivel reinicia el servicio con la configuración de otro nivel
+func (s *RestaurantService) CargarNivel(nivel model.Nivel) {
+	s.Detener()
+	s.nivel = nivel
+	s.reiniciar()
+}
+
+// reiniciar recrea el estado del nivel actual y arranca con un contexto nuevo
+// Solo debe llamarse con las goroutines detenidas
+func (s *RestaurantService) reiniciar() {
+	// El reloj sigue sin pausa y la barra se recrea: los platos del turno anterior se descartan
+	s.reloj.Reanudar()
+	s.aplicarNivel()
+
+	s.mu.Lock()
+	s.platosServidos = 0
+	s.desechadosEnMano = 0
+	s.clientesPerdidos = 0
+	s.platosJugador = 0
+	s.pausado = false
+	s.cerrando = false
+	s.gruposLlegados = 0
+	s.gruposSinMesa = 0
+	s.dinero = 0
+	s.propinas = 0
+	s.satisfaccionTotal = 0
+	s.entregas = 0
+	s.reporteBloqueo = model.ReporteBloqueo{}
+	s.mu.Unlock()
+
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.Start()
+}
+
+// IniciarCierre activa la "hora de cerrar": no se sientan clientes nuevos, los cocineros
+// terminan lo que tienen empezado sin empezar platos nuevos y el mesero puede seguir
+// sirviendo las mesas restantes hasta el plazo
+// El plazo corre con el reloj del juego: en pausa se congela
+func (s *RestaurantService) IniciarCierre(plazo time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cerrando {
+		return
+	}
+	s.cerrando = true
+	s.plazoCierre = s.reloj.Ahora().Add(plazo)
+}
+
+// GetCierre retorna si el restaurante está cerrando y cuánto falta para el plazo
+func (s *RestaurantService) GetCierre() (cerrando bool, restante time.Duration) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.cerrando {
+		return false, 0
+	}
+	return true, max(s.plazoCierre.Sub(s.reloj.Ahora()), 0)
+}
+
+// CierreTerminado indica si ya no queda nada que servir o si venció el plazo del cierre
+// En pausa el cierre nunca termina: el mesero no puede servir y el plazo está congelado
+func (s *RestaurantService) CierreTerminado() bool {
+	s.mu.RLock()
+	cerrando, pausado, plazo := s.cerrando, s.pausado, s.plazoCierre
+	s.mu.RUnlock()
+
+	if !cerrando || pausado {
+		return false
+	}
+	if s.reloj.Ahora().After(plazo) {
+		return true
+	}
+
+	// Esperar a que los cocineros terminen los platos empezados
+	// (los del escenario que esperan un utensilio pueden estar en un interbloqueo: no se los espera)
+	for _, cocinero := range s.cocineros {
+		if cocinero.EstaCocinando() {
+			return false
+		}
+	}
+	for _, cocinero := range s.cocinerosEscenario {
+		if cocinero.EstaCocinando() {
+			return false
+		}
+	}
+	if len(s.etapas) > 0 && s.enPipeline.Load() > 0 {
+		return false
+	}
+
+	// Terminó si no quedan platos para servir o si ya nadie espera un plato
+	// (primero la barra y después la mano: un plato que pasa de una a otra mientras tanto
+	// se ve en alguna de las dos)
+	hayPlatos := len(s.barra) > 0 || s.GetContabilidad().EnMano > 0
+	return !hayPlatos || !s.hayMesasEsperando()
+}
+
+// Close detiene el restaurante y reporta lo que se desperdició
+// Sin un IniciarCierre previo es un cierre inmediato
+func (s *RestaurantService) Close() model.ReporteCierre {
+	s.mu.RLock()
+	reporte := model.ReporteCierre{
+		PorPlazo: s.cerrando && s.reloj.Ahora().After(s.plazoCierre),
+	}
+	s.mu.RUnlock()
+
+	s.Detener()
+
+	for _, cocinero := range s.cocineros {
+		reporte.EnCocina += cocinero.PlatosAbandonados()
+	}
+	for _, etapa := range s.etapas {
+		reporte.EnCocina += len(etapa.entrada)
+		for _, trabajador := range etapa.trabajadores {
+			reporte.EnCocina += trabajador.PlatosAbandonados()
+		}
+	}
+
+	// Los platos que quedan en la barra y en mano se tiran: pasan a desperdiciados
 	close(s.barra)
+	for plato := range s.barra {
+		s.contenidoBarra.Desechar(plato)
+		reporte.EnBarra++
+	}
+
+	reporte.EnMano = s.GetContabilidad().EnMano
+	s.mu.Lock()
+	s.desechadosEnMano += reporte.EnMano
+	s.mu.Unlock()
+
+	s.mesasMu.RLock()
+	for _, mesa := range s.mesas {
+		if !mesa.TienePlato {
+			reporte.ClientesSinAtender += mesa.ClientesActivos
+		}
+	}
+	s.mesasMu.RUnlock()
+
+	return reporte
 }
@@ -0,0 +1,701 @@
+package service
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"restaurant-concurrency/internal/domain/model"
+)
+
+// nivelRapido retorna un nivel con tiempos cortos para que las pruebas terminen rápido
+func nivelRapido() model.Nivel {
+	nivel := model.NivelPorDefecto()
+	nivel.NumCocineros = 3
+	nivel.NumMesas = 4
+	nivel.CoccionBase = 2 * time.Millisecond
+	nivel.CoccionVariacion = 3 * time.Millisecond
+	nivel.IntervaloLlegada = 5 * time.Millisecond
+	nivel.CurvaLlegada = []model.PuntoLlegada{{Desde: 0, Probabilidad: 1}}
+	return nivel
+}
+
+// servirTodo recoge platos de la barra y los entrega en cada mesa con clientes
+func servirTodo(s *RestaurantService) {
+	for _, mesa := range s.GetMesas() {
+		if mesa.ClientesActivos == 0 || mesa.TienePlato {
+			continue
+		}
+		plato, ok := s.IntentarRecogerPlato()
+		if !ok {
+			return
+		}
+		s.EntregarPlatoAMesa(*plato, mesa.PosX, mesa.PosY, 10)
+	}
+}
+
+// plazoEspera es cuánto tiempo real esperan las pruebas a que el servicio llegue a un estado
+const plazoEspera = 2 * time.Second
+
+// esperarHasta revisa la condición cada milisegundo hasta que se cumple o pasa el tope
+// (tiempo real); la condición puede además hacer trabajo, como recoger platos
+func esperarHasta(tope time.Duration, condicion func() bool) bool {
+	limite := time.Now().Add(tope)
+	for !condicion() {
+		if time.Now().After(limite) {
+			return false
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return true
+}
+
+// esperarGoroutines espera a que el número de goroutines baje hasta el esperado
+func esperarGoroutines(t *testing.T, esperado int) {
+	t.Helper()
+	bajaron := esperarHasta(plazoEspera, func() bool {
+		return runtime.NumGoroutine() <= esperado
+	})
+	if !bajaron {
+		buf := make([]byte, 1<<16)
+		n := runtime.Stack(buf, true)
+		t.Fatalf("fuga de goroutines: %d vivas, se esperaban %d\n%s",
+			runtime.NumGoroutine(), esperado, buf[:n])
+	}
+}
+
+// hayPlatosEnBarra indica si algún plato llegó a la barra
+func hayPlatosEnBarra(s *RestaurantService) func() bool {
+	return func() bool { return s.GetEstadoBarra() > 0 }
+}
+
+func TestResetRepetidoNoFugaGoroutines(t *testing.T) {
+	base := runtime.NumGoroutine()
+
+	s := NewRestaurantServiceConNivel(nivelRapido())
+	s.Start()
+
+	for i := 0; i < 25; i++ {
+		// Dejar trabajar a cocineros y generador, y lanzar limpiezas de mesa pendientes
+		for j := 0; j < 5; j++ {
+			time.Sleep(3 * time.Millisecond)
+			servirTodo(s)
+		}
+
+		s.Reset()
+
+		totales, servidos, perdidos := s.GetMetricas()
+		if totales != 0 || servidos != 0 || perdidos != 0 {
+			t.Fatalf("reset %d: métricas no reiniciadas (%d, %d, %d)", i, totales, servidos, perdidos)
+		}
+		if enBarra := s.GetEstadoBarra(); enBarra != 0 {
+			t.Fatalf("reset %d: la barra quedó con %d platos", i, enBarra)
+		}
+		for _, mesa := range s.GetMesas() {
+			if mesa.TienePlato {
+				t.Fatalf("reset %d: la mesa %d conserva un plato", i, mesa.ID)
+			}
+		}
+	}
+
+	s.Close()
+	esperarGoroutines(t, base)
+}
+
+func TestResetReanudaProduccion(t *testing.T) {
+	s := NewRestaurantServiceConNivel(nivelRapido())
+	s.Start()
+	defer s.Close()
+
+	s.Reset()
+
+	if !esperarHasta(plazoEspera, hayPlatosEnBarra(s)) {
+		t.Fatal("los cocineros no volvieron a producir después del reset")
+	}
+}
+
+func TestPipelineLlevaPlatosALaBarra(t *testing.T) {
+	base := runtime.NumGoroutine()
+
+	nivel := nivelRapido()
+	nivel.Etapas = []model.EtapaCocina{
+		{Nombre: "Preparacion", Trabajadores: 2, Duracion: time.Millisecond},
+		{Nombre: "Hornalla", Trabajadores: 1, Duracion: 2 * time.Millisecond, Cola: 2},
+		{Nombre: "Emplatado", Trabajadores: 1, Duracion: time.Millisecond, Cola: 1},
+	}
+	s := NewRestaurantServiceConNivel(nivel)
+	s.Start()
+
+	if !esperarHasta(plazoEspera, hayPlatosEnBarra(s)) {
+		t.Fatal("el pipeline no llevó ningún plato a la barra")
+	}
+
+	etapas := s.GetEtapas()
+	if len(etapas) != 3 {
+		t.Fatalf("se esperaban 3 etapas, hay %d", len(etapas))
+	}
+	for _, etapa := range etapas {
+		if etapa.Procesados == 0 {
+			t.Errorf("la etapa %s no procesó platos", etapa.Nombre)
+		}
+	}
+
+	s.Close()
+	esperarGoroutines(t, base)
+}
+
+func TestEtapasSinEntradaNoSeMarcanEsperandoRecurso(t *testing.T) {
+	nivel := nivelRapido()
+	nivel.CurvaLlegada = []model.PuntoLlegada{{Desde: 0, Probabilidad: 0}} // Nadie llega
+	nivel.Etapas = []model.EtapaCocina{
+		{Nombre: "Preparacion", Trabajadores: 1, Duracion: time.Millisecond},
+		{Nombre: "Hornalla", Trabajadores: 2, Duracion: time.Millisecond, Cola: 1},
+	}
+	s := NewRestaurantServiceConNivel(nivel)
+	s.Start()
+	defer s.Close()
+
+	// Sin demanda la primera etapa no empieza platos y la segunda queda sin entrada
+	sinEntrada := esperarHasta(plazoEspera, func() bool {
+		return contarEnEstado(s.GetLineaTiempo(), model.EstadoSinEntrada) == 2
+	})
+	if !sinEntrada {
+		t.Fatalf("la segunda etapa no quedó sin entrada: %+v", s.GetLineaTiempo())
+	}
+
+	// Sin despensa ni equipos nada bloquea la adquisición: nadie debe figurar esperando un recurso
+	for _, carril := range s.GetLineaTiempo() {
+		for _, tramo := range carril.Tramos {
+			if tramo.Estado == model.EstadoEsperandoRecurso {
+				t.Fatalf("%s figura esperando un recurso sin que nada lo bloquee", carril.Quien)
+			}
+		}
+		if strings.HasPrefix(carril.Quien, "Preparacion") && carril.Actual != model.EstadoSinDemanda {
+			t.Fatalf("la primera etapa figura %q sin demanda", carril.Actual)
+		}
+	}
+}
+
+func TestDespensaSeReponeYCambiaDeReceta(t *testing.T) {
+	nivel := nivelRapido()
+	nivel.Despensa = model.ConfigDespensa{
+		Ingredientes: []model.Ingrediente{{Nombre: "Carne", Capacidad: 1}, {Nombre: "Verdura", Capacidad: 1}},
+		Recetas: []model.Receta{
+			{Nombre: "Milanesa", Ingredientes: map[string]int{"Carne": 1}},
+			{Nombre: "Ensalada", Ingredientes: map[string]int{"Verdura": 1}},
+		},
+		IntervaloReposicion: 20 * time.Millisecond,
+		Reposicion:          map[string]int{"Carne": 1},
+	}
+	s := NewRestaurantServiceConNivel(nivel)
+	s.Start()
+	defer s.Close()
+
+	// Con el stock inicial salen una milanesa y una ensalada; el resto depende del proveedor
+	recetas := make(map[string]int)
+	repuso := esperarHasta(plazoEspera, func() bool {
+		if plato, ok := s.IntentarRecogerPlato(); ok {
+			recetas[plato.Nombre]++
+		}
+		return recetas["Milanesa"] >= 3
+	})
+	if !repuso {
+		t.Fatalf("el proveedor no repuso la despensa (platos: %v)", recetas)
+	}
+
+	if recetas["Ensalada"] > 1 {
+		t.Errorf("se cocinaron %d ensaladas con una sola verdura", recetas["Ensalada"])
+	}
+	ingredientes, _ := s.GetDespensa()
+	for _, ingrediente := range ingredientes {
+		if ingrediente.Stock < 0 || ingrediente.Stock > ingrediente.Capacidad {
+			t.Errorf("stock de %s fuera de rango: %d/%d", ingrediente.Nombre, ingrediente.Stock, ingrediente.Capacidad)
+		}
+	}
+}
+
+func TestEquiposLimitanCocinerosEnParalelo(t *testing.T) {
+	nivel := nivelRapido()
+	nivel.Equipos = []model.EquipoCocina{{Nombre: "Horno", Capacidad: 1}}
+	nivel.Despensa = model.ConfigDespensa{
+		Ingredientes: []model.Ingrediente{{Nombre: "Masa", Capacidad: 1000}},
+		Recetas: []model.Receta{
+			{Nombre: "Pizza", Ingredientes: map[string]int{"Masa": 1}, Equipos: map[string]int{"Horno": 1}},
+		},
+	}
+	s := NewRestaurantServiceConNivel(nivel)
+	s.Start()
+	defer s.Close()
+
+	huboCola, producidos := false, 0
+	cocinaron := esperarHasta(plazoEspera, func() bool {
+		for _, equipo := range s.GetEquipos() {
+			if equipo.EnUso > equipo.Capacidad || len(equipo.Usando) > equipo.Capacidad {
+				t.Fatalf("el %s tiene %d en uso (%v) con capacidad %d",
+					equipo.Nombre, equipo.EnUso, equipo.Usando, equipo.Capacidad)
+			}
+			huboCola = huboCola || len(equipo.Esperando) > 0
+		}
+		if _, ok := s.IntentarRecogerPlato(); ok {
+			producidos++
+		}
+		return producidos >= 10
+	})
+	if !cocinaron {
+		t.Fatalf("solo se cocinaron %d pizzas", producidos)
+	}
+
+	if !huboCola {
+		t.Error("con 3 cocineros y un horno nunca hubo cola")
+	}
+}
+
+// pasoVigilante es cuánto avanza el reloj manual en cada paso de las pruebas del vigilante
+const pasoVigilante = 50 * time.Millisecond
+
+// avanzarHasta hace correr el reloj manual de a pasos hasta que se cumple la condición
+// o pasa el tiempo de juego indicado; entre paso y paso deja reaccionar a las goroutines
+func avanzarHasta(reloj *model.Reloj, tope time.Duration, condicion func() bool) bool {
+	for transcurrido := time.Duration(0); transcurrido < tope; transcurrido += pasoVigilante {
+		if condicion() {
+			return true
+		}
+		reloj.Avanzar(pasoVigilante)
+		time.Sleep(time.Millisecond)
+	}
+	return condicion()
+}
+
+func TestVigilanteDetectaInterbloqueoYCorreccion(t *testing.T) {
+	base := runtime.NumGoroutine()
+
+	reloj := model.NewRelojManual()
+	s := NewRestaurantServiceConReloj(nivelRapido(), reloj)
+	s.SetEscenario(model.Escenario{Tipo: model.EscenarioInterbloqueo})
+	s.Start() // Sin escenario: el escenario se aplica en el Reset
+	s.Reset()
+
+	detectado := avanzarHasta(reloj, model.UmbralAtasco+5*time.Second, func() bool {
+		return s.GetReporteBloqueo().HayInterbloqueo()
+	})
+	if !detectado {
+		t.Fatalf("el vigilante no detectó el interbloqueo: %+v", s.GetReporteBloqueo())
+	}
+	if ciclo := s.GetReporteBloqueo().Ciclo; len(ciclo) != 4 {
+		t.Fatalf("se esperaba un ciclo entre dos cocineros y dos utensilios, se obtuvo %v", ciclo)
+	}
+
+	// Con el orden global de adquisición los cocineros siguen produciendo
+	s.SetEscenario(model.Escenario{Tipo: model.EscenarioInterbloqueo, Corregido: true})
+	s.Reset()
+
+	producidos := 0
+	avanzarHasta(reloj, model.UmbralAtasco+2*time.Second, func() bool {
+		if _, ok := s.IntentarRecogerPlato(); ok {
+			producidos++
+		}
+		return false
+	})
+	if reporte := s.GetReporteBloqueo(); reporte.HayInterbloqueo() {
+		t.Fatalf("con la corrección el vigilante reportó un ciclo: %s", reporte.DescribirCiclo())
+	}
+	if producidos < 3 {
+		t.Fatalf("con la corrección solo se produjeron %d platos", producidos)
+	}
+
+	// Cerrar en pleno escenario no deja goroutines colgadas en los utensilios
+	s.Close()
+	esperarGoroutines(t, base)
+}
+
+func TestLineaTiempoMuestraCocinerosBloqueadosPorBarraLlena(t *testing.T) {
+	nivel := nivelRapido()
+	nivel.CapacidadBarra = 1
+	s := NewRestaurantServiceConNivel(nivel)
+	s.Start()
+	defer s.Close()
+
+	// Nadie sirve: la barra se llena y todos los cocineros quedan bloqueados con el plato en mano
+	bloqueados := esperarHasta(plazoEspera, func() bool {
+		return contarEnEstado(s.GetLineaTiempo(), model.EstadoBarraLlena) == nivel.NumCocineros
+	})
+	if !bloqueados {
+		t.Fatalf("se esperaban %d cocineros con la barra llena: %+v", nivel.NumCocineros, s.GetLineaTiempo())
+	}
+
+	carriles := s.GetLineaTiempo()
+	if len(carriles) != nivel.NumCocineros || carriles[0].Quien != "Cocinero 1" {
+		t.Fatalf("carriles inesperados: %+v", carriles)
+	}
+	for _, carril := range carriles {
+		cocino := false
+		for _, tramo := range carril.Tramos {
+			cocino = cocino || tramo.Estado == model.EstadoCocinando
+			if tramo.Hasta.Before(tramo.Desde) {
+				t.Fatalf("%s tiene un tramo invertido: %+v", carril.Quien, tramo)
+			}
+		}
+		if carril.Actual == model.EstadoBarraLlena && !cocino {
+			t.Errorf("%s quedó bloqueado sin haber cocinado: %+v", carril.Quien, carril.Tramos)
+		}
+	}
+}
+
+func TestBloqueosBarraAcumulanTiempo(t *testing.T) {
+	nivel := nivelRapido()
+	nivel.CapacidadBarra = 1
+	reloj := model.NewRelojManual()
+	s := NewRestaurantServiceConReloj(nivel, reloj)
+	s.Start()
+	defer s.Close()
+
+	bloqueados := avanzarHasta(reloj, 10*time.Second, func() bool {
+		return todosBloqueados(s.GetBloqueosBarra())
+	})
+	if !bloqueados {
+		t.Fatalf("los cocineros no quedaron bloqueados: %+v", s.GetBloqueosBarra())
+	}
+	reloj.Avanzar(time.Second)
+
+	antes := s.GetBloqueosBarra()
+	for _, bloqueo := range antes {
+		if bloqueo.Espera < time.Second || bloqueo.Acumulado < bloqueo.Espera {
+			t.Fatalf("%s: espera %v, acumulado %v", bloqueo.Cocinero, bloqueo.Espera, bloqueo.Acumulado)
+		}
+	}
+
+	// Al liberar lugar en la barra alguien se desbloquea, pero lo acumulado no se pierde
+	// (con el reloj quieto el que se desbloquea se queda cocinando)
+	if _, ok := s.IntentarRecogerPlato(); !ok {
+		t.Fatal("la barra llena no tenía platos")
+	}
+	desbloqueado := esperarHasta(plazoEspera, func() bool {
+		return !todosBloqueados(s.GetBloqueosBarra())
+	})
+	if !desbloqueado {
+		t.Fatalf("nadie se desbloqueó al liberar la barra: %+v", s.GetBloqueosBarra())
+	}
+	for i, bloqueo := range s.GetBloqueosBarra() {
+		if bloqueo.Acumulado < antes[i].Acumulado {
+			t.Errorf("%s: el acumulado bajó de %v a %v", bloqueo.Cocinero, antes[i].Acumulado, bloqueo.Acumulado)
+		}
+	}
+}
+
+// todosBloqueados indica si todos los cocineros esperan con un plato en mano
+func todosBloqueados(bloqueos []model.BloqueoProductor) bool {
+	for _, bloqueo := range bloqueos {
+		if !bloqueo.Bloqueado {
+			return false
+		}
+	}
+	return len(bloqueos) > 0
+}
+
+// contarEnEstado cuenta los carriles de la línea de tiempo que están ahora en el estado
+func contarEnEstado(carriles []model.CarrilEstados, estado model.EstadoGoroutine) int {
+	cuantos := 0
+	for _, carril := range carriles {
+		if carril.Actual == estado {
+			cuantos++
+		}
+	}
+	return cuantos
+}
+
+func TestGetCocinerosCuentaPlatosPorCocinero(t *testing.T) {
+	nivel := nivelRapido()
+	nivel.CapacidadBarra = 6
+	s := NewRestaurantServiceConNivel(nivel)
+	s.Start()
+	defer s.Close()
+
+	// Sin nadie que sirva, lo que produjo cada cocinero es exactamente lo que hay en la barra
+	// (con todos bloqueados ya no queda ningún envío a medio contar)
+	llena := esperarHasta(plazoEspera, func() bool {
+		return s.GetEstadoBarra() == nivel.CapacidadBarra && todosBloqueados(s.GetBloqueosBarra())
+	})
+	if !llena {
+		t.Fatalf("la barra no se llenó: %d/%d", s.GetEstadoBarra(), nivel.CapacidadBarra)
+	}
+
+	cocineros := s.GetCocineros()
+	if len(cocineros) != nivel.NumCocineros {
+		t.Fatalf("se esperaban %d cocineros, se obtuvieron %d", nivel.NumCocineros, len(cocineros))
+	}
+	producidos := 0
+	for i, cocinero := range cocineros {
+		if cocinero.ID != i+1 {
+			t.Errorf("cocinero %d tiene ID %d", i, cocinero.ID)
+		}
+		if progreso := cocinero.Progreso(); progreso < 0 || progreso > 1 {
+			t.Errorf("%s: progreso fuera de rango %v", cocinero.Nombre, progreso)
+		}
+		producidos += cocinero.Producidos
+	}
+	if producidos != s.GetEstadoBarra() {
+		t.Fatalf("los cocineros produjeron %d platos pero la barra tiene %d", producidos, s.GetEstadoBarra())
+	}
+}
+
+func TestBarraSnapshotReflejaLosPlatosDelCanal(t *testing.T) {
+	nivel := nivelRapido()
+	nivel.CapacidadBarra = 4
+	s := NewRestaurantServiceConNivel(nivel)
+	s.Start()
+	defer s.Close()
+
+	// Recoger y depositar a la vez para ejercitar el reflejo con varios productores
+	limite := time.Now().Add(300 * time.Millisecond)
+	for time.Now().Before(limite) {
+		s.IntentarRecogerPlato()
+		s.DepositarPlato("")
+		time.Sleep(time.Millisecond)
+	}
+	s.Detener()
+
+	platos := s.GetBarraSnapshot()
+	if len(platos) != s.GetEstadoBarra() {
+		t.Fatalf("el reflejo tiene %d platos y el canal %d", len(platos), s.GetEstadoBarra())
+	}
+	enReflejo := make(map[[2]int]bool, len(platos))
+	for i, plato := range platos {
+		if plato.Siguiente != (i == 0) {
+			t.Errorf("lugar %d marcado como siguiente = %v", i, plato.Siguiente)
+		}
+		enReflejo[[2]int{plato.ID, plato.CocineroID}] = true
+	}
+
+	// Cada plato que sale del canal estaba en el reflejo, y al final no queda ninguno
+	for {
+		plato, ok := s.IntentarRecogerPlato()
+		if !ok {
+			break
+		}
+		if !enReflejo[[2]int{plato.ID, plato.CocineroID}] {
+			t.Errorf("el plato #%d del cocinero %d no estaba en el reflejo", plato.ID, plato.CocineroID)
+		}
+	}
+	if restantes := s.GetBarraSnapshot(); len(restantes) != 0 {
+		t.Fatalf("el reflejo conserva platos con la barra vacía: %+v", restantes)
+	}
+}
+
+func TestContabilidadPlatosEsConsistente(t *testing.T) {
+	nivel := nivelRapido()
+	nivel.CapacidadBarra = 3
+	s := NewRestaurantServiceConNivel(nivel)
+	s.Start()
+
+	// Varios meseros recogen y entregan a la vez mientras el jugador deposita platos
+	var wg sync.WaitGroup
+	var idsMu sync.Mutex
+	ids := make(map[int]int)
+	limite := time.Now().Add(300 * time.Millisecond)
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for time.Now().Before(limite) {
+				if plato, ok := s.IntentarRecogerPlato(); ok {
+					idsMu.Lock()
+					ids[plato.ID]++
+					idsMu.Unlock()
+					// Sin mesa a tiro el plato queda en mano
+					for _, mesa := range s.GetMesas() {
+						if mesa.ClientesActivos > 0 && !mesa.TienePlato {
+							s.EntregarPlatoAMesa(*plato, mesa.PosX, mesa.PosY, 10)
+							break
+						}
+					}
+				}
+				time.Sleep(time.Millisecond)
+			}
+		}()
+	}
+	for time.Now().Before(limite) {
+		s.DepositarPlato("")
+		if c := s.GetContabilidad(); !c.Consistente() {
+			t.Fatalf("contabilidad inconsistente durante el turno: %+v", c)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	wg.Wait()
+	s.Detener()
+
+	c := s.GetContabilidad()
+	if !c.Consistente() {
+		t.Fatalf("contabilidad inconsistente al detener: %+v", c)
+	}
+	if c.EnBarra != s.GetEstadoBarra() || c.EnBarra != len(s.GetBarraSnapshot()) {
+		t.Fatalf("en barra: contabilidad %d, canal %d, reflejo %d",
+			c.EnBarra, s.GetEstadoBarra(), len(s.GetBarraSnapshot()))
+	}
+	if c.Recogidos != len(ids) {
+		t.Fatalf("se recogieron %d platos distintos y la contabilidad cuenta %d", len(ids), c.Recogidos)
+	}
+	for id, veces := range ids {
+		if veces > 1 || id < 1 || id > s.numerador.Emitidos() {
+			t.Fatalf("plato #%d recogido %d veces (emitidos: %d)", id, veces, s.numerador.Emitidos())
+		}
+	}
+
+	// Al cerrar, lo que queda en la barra y en mano pasa a desperdiciados (sin contarse dos veces)
+	reporte := s.Close()
+	if reporte.EnBarra != c.EnBarra || reporte.EnMano != c.EnMano {
+		t.Fatalf("el cierre reporta %d en barra y %d en mano; la contabilidad %d y %d",
+			reporte.EnBarra, reporte.EnMano, c.EnBarra, c.EnMano)
+	}
+	final := s.GetContabilidad()
+	if !final.Consistente() || final.EnBarra != 0 || final.EnMano != 0 {
+		t.Fatalf("contabilidad después de cerrar: %+v", final)
+	}
+	if final.Desperdiciados != reporte.EnBarra+reporte.EnMano {
+		t.Fatalf("desperdiciados: contabilidad %d, cierre %d en barra + %d en mano",
+			final.Desperdiciados, reporte.EnBarra, reporte.EnMano)
+	}
+}
+
+func TestPlazoCierreSeCongelaEnPausa(t *testing.T) {
+	reloj := model.NewRelojManual()
+	s := NewRestaurantServiceConReloj(nivelRapido(), reloj)
+	s.Start()
+	// Detener y no Close: Close se llama al final para ver el reporte y no admite dos llamadas
+	defer s.Detener()
+
+	s.IniciarCierre(10 * time.Second)
+	s.TogglePausar()
+	reloj.Avanzar(time.Minute)
+	if _, restante := s.GetCierre(); restante != 10*time.Second {
+		t.Fatalf("en pausa el plazo del cierre bajó a %v", restante)
+	}
+	if s.CierreTerminado() {
+		t.Fatal("el cierre terminó con el juego en pausa")
+	}
+
+	s.TogglePausar()
+	reloj.Avanzar(4 * time.Second)
+	if _, restante := s.GetCierre(); restante != 6*time.Second {
+		t.Fatalf("después de 4s quedan %v de plazo, se esperaban 6s", restante)
+	}
+	reloj.Avanzar(7 * time.Second)
+	if !s.CierreTerminado() {
+		t.Fatal("el cierre no terminó al vencer el plazo")
+	}
+	if reporte := s.Close(); !reporte.PorPlazo {
+		t.Fatal("el cierre no se reportó como cortado por el plazo")
+	}
+}
+
+func TestReanudarDejaTerminarElCierreEnPausa(t *testing.T) {
+	reloj := model.NewRelojManual()
+	s := NewRestaurantServiceConReloj(nivelRapido(), reloj)
+	s.Start()
+	defer s.Close()
+
+	// Así cierra una señal del sistema con el juego en pausa: reanuda y el plazo vuelve a correr
+	s.TogglePausar()
+	s.Reanudar()
+	s.IniciarCierre(10 * time.Second)
+	reloj.Avanzar(11 * time.Second)
+	if s.EstaPausado() {
+		t.Fatal("Reanudar dejó el juego en pausa")
+	}
+	if !s.CierreTerminado() {
+		t.Fatal("el cierre no terminó al vencer el plazo después de reanudar")
+	}
+}
+
+func TestPausarSoloAvisaSiPauso(t *testing.T) {
+	s := NewRestaurantServiceConReloj(nivelRapido(), model.NewRelojManual())
+
+	// La confirmación de salida solo reanuda si fue ella la que pausó
+	if !s.Pausar() || !s.EstaPausado() {
+		t.Fatal("Pausar no pausó el juego")
+	}
+	if s.Pausar() {
+		t.Fatal("Pausar avisó que pausó un juego que ya estaba en pausa")
+	}
+	s.Reanudar()
+	if s.EstaPausado() {
+		t.Fatal("Reanudar dejó el juego en pausa")
+	}
+}
+
+func TestCierreNoEsperaCocinerosSinIngredientes(t *testing.T) {
+	nivel := nivelRapido()
+	nivel.Despensa = model.ConfigDespensa{
+		Ingredientes: []model.Ingrediente{{Nombre: "Carne", Capacidad: 1}},
+		Recetas:      []model.Receta{{Nombre: "Milanesa", Ingredientes: map[string]int{"Carne": 1}}},
+	}
+	s := NewRestaurantServiceConNivel(nivel)
+	s.Start()
+	defer s.Close()
+
+	// Sale una sola milanesa y los cocineros quedan esperando carne que nunca llega
+	bloqueados := esperarHasta(plazoEspera, func() bool {
+		servirTodo(s)
+		return contarEnEstado(s.GetLineaTiempo(), model.EstadoEsperandoRecurso) == nivel.NumCocineros
+	})
+	if !bloqueados {
+		t.Fatalf("los cocineros no quedaron esperando ingredientes: %+v", s.GetLineaTiempo())
+	}
+	servirTodo(s)
+
+	// Sin platos empezados ni platos para servir el cierre termina sin esperar el plazo
+	s.IniciarCierre(time.Minute)
+	if !esperarHasta(plazoEspera, s.CierreTerminado) {
+		t.Fatal("el cierre esperó a cocineros que no tienen ingredientes")
+	}
+}
+
+func TestCierreNoEsperaCocinerosEnInterbloqueo(t *testing.T) {
+	reloj := model.NewRelojManual()
+	s := NewRestaurantServiceConReloj(nivelRapido(), reloj)
+	s.SetEscenario(model.Escenario{Tipo: model.EscenarioInterbloqueo})
+	s.Start()
+	s.Reset()
+	defer s.Close()
+
+	detectado := avanzarHasta(reloj, model.UmbralAtasco+5*time.Second, func() bool {
+		servirTodo(s)
+		return s.GetReporteBloqueo().HayInterbloqueo()
+	})
+	if !detectado {
+		t.Fatalf("el vigilante no detectó el interbloqueo: %+v", s.GetReporteBloqueo())
+	}
+
+	// Los cocineros del escenario esperan un utensilio que nunca se libera: el cierre no los espera
+	s.IniciarCierre(time.Minute)
+	if !esperarHasta(plazoEspera, s.CierreTerminado) {
+		t.Fatal("el cierre esperó a cocineros en interbloqueo")
+	}
+}
+
+func TestCierreEsperaLosPlatosDelPipeline(t *testing.T) {
+	nivel := nivelRapido()
+	nivel.Etapas = []model.EtapaCocina{
+		{Nombre: "Preparacion", Trabajadores: 2, Duracion: time.Millisecond},
+		{Nombre: "Hornalla", Trabajadores: 2, Duracion: 3 * time.Millisecond, Cola: 1},
+		{Nombre: "Emplatado", Trabajadores: 1, Duracion: time.Millisecond, Cola: 1},
+	}
+	s := NewRestaurantServiceConNivel(nivel)
+	s.Start()
+	time.Sleep(20 * time.Millisecond)
+
+	// Mientras quede un plato dentro del pipeline el cierre no puede darse por terminado:
+	// al terminar no queda nada para tirar en la cocina
+	s.IniciarCierre(time.Minute)
+	terminado := esperarHasta(plazoEspera, func() bool {
+		servirTodo(s)
+		return s.CierreTerminado()
+	})
+	if !terminado {
+		t.Fatal("el cierre no terminó")
+	}
+	if reporte := s.Close(); reporte.EnCocina != 0 || reporte.PorPlazo {
+		t.Fatalf("el cierre terminó con %d platos en la cocina (por plazo: %v)", reporte.EnCocina, reporte.PorPlazo)
+	}
+}
@@ -19,6 +19,9 @@ type Config struct {
 
 	// Logging
 	Logging LoggingConfig `json:"logging"`
+
+	// Teclas asignadas a cada acción (acción -> nombres de tecla de Ebiten)
+	Controles map[string][]string `json:"controles,omitempty"`
 }
 
 type WindowConfig struct {
@@ -114,3 +117,31 @@ func (c *Config) SaveConfig(path string) error {
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(c)
 }
+
+// ArchivoControles guarda el perfil de controles en la sección "controles" del archivo de configuración
+type ArchivoControles struct {
+	path string
+}
+
+func NewArchivoControles(path string) *ArchivoControles {
+	return &ArchivoControles{path: path}
+}
+
+// Cargar retorna el perfil guardado (vacío si no hay archivo o sección)
+func (a *ArchivoControles) Cargar() (map[string][]string, error) {
+	config, err := LoadConfig(a.path)
+	if err != nil {
+		return nil, err
+	}
+	return config.Controles, nil
+}
+
+// Guardar reemplaza el perfil conservando el resto de la configuración
+func (a *ArchivoControles) Guardar(perfil map[string][]string) error {
+	config, err := LoadConfig(a.path)
+	if err != nil {
+		return err
+	}
+	config.Controles = perfil
+	return config.SaveConfig(a.path)
+}
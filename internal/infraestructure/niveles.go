@@ -0,0 +1,379 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"restaurant-concurrency/internal/domain/model"
+	"time"
+)
+
+// NivelConfig es el formato JSON de un archivo de nivel
+type NivelConfig struct {
+	Nombre             string               `json:"nombre"`
+	Mesas              int                  `json:"mesas"`
+	PacienciaS         int                  `json:"paciencia_s"`
+	NumCocineros       int                  `json:"num_cocineros"`
+	CapacidadBarra     int                  `json:"capacidad_barra"`
+	CoccionMs          int                  `json:"coccion_ms"`
+	CoccionVariacionMs int                  `json:"coccion_variacion_ms"`
+	Etapas             []EtapaConfig        `json:"etapas"`
+	Equipos            []EquipoConfig       `json:"equipos"`
+	Despensa           DespensaConfig       `json:"despensa"`
+	CapacidadBandeja   int                  `json:"capacidad_bandeja"`
+	IntervaloLlegadaMs int                  `json:"intervalo_llegada_ms"`
+	CurvaLlegada       []PuntoLlegadaConfig `json:"curva_llegada"`
+	Llegadas           LlegadasConfig       `json:"llegadas"`
+	Turno              TurnoConfig          `json:"turno"`
+}
+
+// EtapaConfig es una etapa del pipeline de la cocina (ej. preparación -> hornalla -> emplatado)
+type EtapaConfig struct {
+	Nombre       string         `json:"nombre"`
+	Trabajadores int            `json:"trabajadores"`
+	DuracionMs   int            `json:"duracion_ms"`
+	VariacionMs  int            `json:"variacion_ms"`
+	Cola         int            `json:"cola"`
+	Equipos      map[string]int `json:"equipos"`
+}
+
+// EquipoConfig es un equipo compartido de la cocina (ej. 2 hornos)
+type EquipoConfig struct {
+	Nombre    string `json:"nombre"`
+	Capacidad int    `json:"capacidad"`
+}
+
+// DespensaConfig describe los ingredientes, las recetas y la reposición del proveedor
+// Sin recetas los cocineros no consumen ingredientes
+type DespensaConfig struct {
+	Ingredientes []IngredienteConfig `json:"ingredientes"`
+	Recetas      []RecetaConfig      `json:"recetas"`
+	Reposicion   ReposicionConfig    `json:"reposicion"`
+}
+
+// IngredienteConfig es un ingrediente con su stock máximo
+type IngredienteConfig struct {
+	Nombre    string `json:"nombre"`
+	Capacidad int    `json:"capacidad"`
+}
+
+// RecetaConfig es una receta (ingrediente -> cantidad por plato)
+type RecetaConfig struct {
+	Nombre       string         `json:"nombre"`
+	Ingredientes map[string]int `json:"ingredientes"`
+	Equipos      map[string]int `json:"equipos"`
+}
+
+// ReposicionConfig es lo que entrega el proveedor cada cierto tiempo
+type ReposicionConfig struct {
+	IntervaloS int            `json:"intervalo_s"`
+	Cantidades map[string]int `json:"cantidades"`
+}
+
+// PuntoLlegadaConfig es un punto de la curva de llegada (segundo del turno -> probabilidad)
+type PuntoLlegadaConfig struct {
+	DesdeS       int     `json:"desde_s"`
+	Probabilidad float64 `json:"probabilidad"`
+}
+
+// LlegadasConfig describe el proceso de llegada de clientes
+// tipo: "curva" (por defecto), "poisson", "programada" o "rafagas"
+type LlegadasConfig struct {
+	Tipo          string               `json:"tipo"`
+	TasaPorMinuto float64              `json:"tasa_por_minuto"`
+	Tramos        []TramoLlegadaConfig `json:"tramos"`
+	Rafaga        RafagaConfig         `json:"rafaga"`
+	Grupos        []PesoGrupoConfig    `json:"grupos"`
+}
+
+// TramoLlegadaConfig es un tramo del horario programado (ej. hora pico del almuerzo)
+type TramoLlegadaConfig struct {
+	Nombre        string  `json:"nombre"`
+	DesdeS        int     `json:"desde_s"`
+	TasaPorMinuto float64 `json:"tasa_por_minuto"`
+}
+
+// RafagaConfig configura las ráfagas periódicas de grupos
+type RafagaConfig struct {
+	PeriodoS int `json:"periodo_s"`
+	Grupos   int `json:"grupos"`
+}
+
+// PesoGrupoConfig es el peso relativo de un tamaño de grupo
+type PesoGrupoConfig struct {
+	Tamano int     `json:"tamano"`
+	Peso   float64 `json:"peso"`
+}
+
+// TurnoConfig contiene las metas del turno de un nivel
+type TurnoConfig struct {
+	DuracionS   int     `json:"duracion_s"`
+	MetaDinero  float64 `json:"meta_dinero"`
+	MaxPerdidos int     `json:"max_perdidos"`
+}
+
+// CargarNiveles carga todos los niveles (*.json) de un directorio, ordenados por nombre de archivo
+func CargarNiveles(dir string) ([]model.Nivel, error) {
+	archivos, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	niveles := make([]model.Nivel, 0, len(archivos))
+	for i, archivo := range archivos {
+		nivel, err := CargarNivel(archivo)
+		if err != nil {
+			return nil, err
+		}
+		nivel.Numero = i + 1
+		niveles = append(niveles, nivel)
+	}
+	return niveles, nil
+}
+
+// CargarNivel carga un nivel desde un archivo JSON; los campos omitidos toman el valor por defecto
+func CargarNivel(path string) (model.Nivel, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return model.Nivel{}, err
+	}
+	defer file.Close()
+
+	var config NivelConfig
+	if err := json.NewDecoder(file).Decode(&config); err != nil {
+		return model.Nivel{}, fmt.Errorf("nivel %s: %w", path, err)
+	}
+
+	// mesas 0 (u omitido) toma el valor por defecto
+	if config.Mesas < 0 {
+		return model.Nivel{}, fmt.Errorf("nivel %s: la cantidad de mesas no puede ser negativa (%d)", path, config.Mesas)
+	}
+	if err := config.Llegadas.Validar(); err != nil {
+		return model.Nivel{}, fmt.Errorf("nivel %s: %w", path, err)
+	}
+	if err := config.Despensa.Validar(); err != nil {
+		return model.Nivel{}, fmt.Errorf("nivel %s: %w", path, err)
+	}
+	if err := config.validarEquipos(); err != nil {
+		return model.Nivel{}, fmt.Errorf("nivel %s: %w", path, err)
+	}
+	return config.ToModel(), nil
+}
+
+// validarEquipos verifica que recetas y etapas pidan equipos que existen y sin pasar su capacidad
+// (un pedido mayor a la capacidad dejaría al cocinero esperando para siempre)
+// Con pipeline los equipos se piden por etapa: una receta que pide equipos se rechaza
+// en lugar de ignorarse
+func (c NivelConfig) validarEquipos() error {
+	capacidades := make(map[string]int, len(c.Equipos))
+	for _, equipo := range c.Equipos {
+		if equipo.Capacidad <= 0 {
+			return fmt.Errorf("el equipo %q necesita capacidad positiva", equipo.Nombre)
+		}
+		capacidades[equipo.Nombre] = equipo.Capacidad
+	}
+
+	for _, receta := range c.Despensa.Recetas {
+		if len(c.Etapas) > 0 && len(receta.Equipos) > 0 {
+			return fmt.Errorf("la receta %q pide equipos, pero con etapas los equipos se piden en cada etapa", receta.Nombre)
+		}
+		if err := validarPedidoEquipos("la receta "+receta.Nombre, receta.Equipos, capacidades); err != nil {
+			return err
+		}
+	}
+	for _, etapa := range c.Etapas {
+		if err := validarPedidoEquipos("la etapa "+etapa.Nombre, etapa.Equipos, capacidades); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validarPedidoEquipos(quien string, pedido, capacidades map[string]int) error {
+	for nombre, unidades := range pedido {
+		capacidad, ok := capacidades[nombre]
+		if !ok {
+			return fmt.Errorf("%s pide el equipo desconocido %q", quien, nombre)
+		}
+		if unidades > capacidad {
+			return fmt.Errorf("%s pide %d %q y solo hay %d", quien, unidades, nombre, capacidad)
+		}
+	}
+	return nil
+}
+
+// Validar verifica que las recetas y la reposición solo usen ingredientes de la despensa
+// y que ninguna receta pida más de lo que entra de un ingrediente (el cocinero esperaría
+// para siempre una reposición que no puede llegar)
+func (c DespensaConfig) Validar() error {
+	capacidades := make(map[string]int, len(c.Ingredientes))
+	for _, ingrediente := range c.Ingredientes {
+		capacidades[ingrediente.Nombre] = ingrediente.Capacidad
+	}
+	for _, receta := range c.Recetas {
+		for nombre, cantidad := range receta.Ingredientes {
+			capacidad, ok := capacidades[nombre]
+			if !ok {
+				return fmt.Errorf("la receta %q usa el ingrediente desconocido %q", receta.Nombre, nombre)
+			}
+			if cantidad > capacidad {
+				return fmt.Errorf("la receta %q pide %d de %q y la despensa guarda %d", receta.Nombre, cantidad, nombre, capacidad)
+			}
+		}
+	}
+	for nombre := range c.Reposicion.Cantidades {
+		if _, ok := capacidades[nombre]; !ok {
+			return fmt.Errorf("la reposición trae el ingrediente desconocido %q", nombre)
+		}
+	}
+	return nil
+}
+
+// ToModel convierte la configuración JSON al nivel del dominio
+func (c NivelConfig) ToModel() model.Nivel {
+	nivel := model.NivelPorDefecto()
+
+	if c.Nombre != "" {
+		nivel.Nombre = c.Nombre
+	}
+	if c.Mesas > 0 {
+		nivel.NumMesas = c.Mesas
+	}
+	if c.PacienciaS > 0 {
+		nivel.Paciencia = time.Duration(c.PacienciaS) * time.Second
+	}
+	if c.NumCocineros > 0 {
+		nivel.NumCocineros = c.NumCocineros
+	}
+	if c.CapacidadBarra > 0 {
+		nivel.CapacidadBarra = c.CapacidadBarra
+	}
+	if c.CoccionMs > 0 {
+		nivel.CoccionBase = time.Duration(c.CoccionMs) * time.Millisecond
+	}
+	if c.CoccionVariacionMs > 0 {
+		nivel.CoccionVariacion = time.Duration(c.CoccionVariacionMs) * time.Millisecond
+	}
+	for _, etapa := range c.Etapas {
+		nivel.Etapas = append(nivel.Etapas, model.EtapaCocina{
+			Nombre:       etapa.Nombre,
+			Trabajadores: max(1, etapa.Trabajadores),
+			Duracion:     time.Duration(etapa.DuracionMs) * time.Millisecond,
+			Variacion:    time.Duration(etapa.VariacionMs) * time.Millisecond,
+			Cola:         etapa.Cola,
+			Equipos:      etapa.Equipos,
+		})
+	}
+	for _, equipo := range c.Equipos {
+		nivel.Equipos = append(nivel.Equipos, model.EquipoCocina{Nombre: equipo.Nombre, Capacidad: equipo.Capacidad})
+	}
+	nivel.Despensa = c.Despensa.ToModel()
+	if c.CapacidadBandeja > 0 {
+		nivel.CapacidadBandeja = c.CapacidadBandeja
+	}
+	if c.IntervaloLlegadaMs > 0 {
+		nivel.IntervaloLlegada = time.Duration(c.IntervaloLlegadaMs) * time.Millisecond
+	}
+	if len(c.CurvaLlegada) > 0 {
+		nivel.CurvaLlegada = make([]model.PuntoLlegada, len(c.CurvaLlegada))
+		for i, punto := range c.CurvaLlegada {
+			nivel.CurvaLlegada[i] = model.PuntoLlegada{
+				Desde:        time.Duration(punto.DesdeS) * time.Second,
+				Probabilidad: punto.Probabilidad,
+			}
+		}
+	}
+	nivel.Llegadas = c.Llegadas.ToModel()
+	if c.Turno.DuracionS > 0 {
+		nivel.DuracionTurno = time.Duration(c.Turno.DuracionS) * time.Second
+	}
+	if c.Turno.MetaDinero > 0 {
+		nivel.MetaDinero = c.Turno.MetaDinero
+	}
+	if c.Turno.MaxPerdidos > 0 {
+		nivel.MaxPerdidos = c.Turno.MaxPerdidos
+	}
+
+	return nivel
+}
+
+// ToModel convierte la configuración de la despensa al dominio
+func (c DespensaConfig) ToModel() model.ConfigDespensa {
+	despensa := model.ConfigDespensa{
+		IntervaloReposicion: time.Duration(c.Reposicion.IntervaloS) * time.Second,
+		Reposicion:          c.Reposicion.Cantidades,
+	}
+	for _, ingrediente := range c.Ingredientes {
+		despensa.Ingredientes = append(despensa.Ingredientes, model.Ingrediente{
+			Nombre:    ingrediente.Nombre,
+			Capacidad: ingrediente.Capacidad,
+		})
+	}
+	for _, receta := range c.Recetas {
+		despensa.Recetas = append(despensa.Recetas, model.Receta{
+			Nombre:       receta.Nombre,
+			Ingredientes: receta.Ingredientes,
+			Equipos:      receta.Equipos,
+		})
+	}
+	return despensa
+}
+
+// Validar verifica el tipo de llegada y que tasas, ráfagas y grupos tengan sentido
+// (una ráfaga sin período o sin grupos nunca llega y una tasa negativa no es una tasa)
+func (c LlegadasConfig) Validar() error {
+	switch model.TipoLlegada(c.Tipo) {
+	case "", model.LlegadaCurva, model.LlegadaPoisson, model.LlegadaProgramada, model.LlegadaRafagas:
+	default:
+		return fmt.Errorf("tipo de llegada desconocido %q", c.Tipo)
+	}
+	if c.TasaPorMinuto < 0 {
+		return fmt.Errorf("la tasa de llegada no puede ser negativa (%g por minuto)", c.TasaPorMinuto)
+	}
+	for _, tramo := range c.Tramos {
+		if tramo.TasaPorMinuto < 0 {
+			return fmt.Errorf("el tramo %q tiene tasa de llegada negativa (%g por minuto)", tramo.Nombre, tramo.TasaPorMinuto)
+		}
+	}
+	if model.TipoLlegada(c.Tipo) == model.LlegadaRafagas {
+		if c.Rafaga.PeriodoS <= 0 {
+			return fmt.Errorf("la ráfaga necesita periodo_s positivo (tiene %d)", c.Rafaga.PeriodoS)
+		}
+		if c.Rafaga.Grupos <= 0 {
+			return fmt.Errorf("la ráfaga necesita grupos positivos (tiene %d)", c.Rafaga.Grupos)
+		}
+	}
+	for _, grupo := range c.Grupos {
+		if grupo.Tamano <= 0 || grupo.Peso < 0 {
+			return fmt.Errorf("el grupo de %d personas con peso %g no es válido", grupo.Tamano, grupo.Peso)
+		}
+	}
+	return nil
+}
+
+// ToModel convierte la configuración de llegadas al patrón del dominio
+func (c LlegadasConfig) ToModel() model.PatronLlegada {
+	patron := model.PatronLlegada{
+		Tipo:          model.TipoLlegada(c.Tipo),
+		TasaPorMinuto: c.TasaPorMinuto,
+		PeriodoRafaga: time.Duration(c.Rafaga.PeriodoS) * time.Second,
+		TamanoRafaga:  c.Rafaga.Grupos,
+	}
+	if patron.Tipo == "" {
+		patron.Tipo = model.LlegadaCurva
+	}
+
+	for _, tramo := range c.Tramos {
+		patron.Tramos = append(patron.Tramos, model.TramoLlegada{
+			Nombre:        tramo.Nombre,
+			Desde:         time.Duration(tramo.DesdeS) * time.Second,
+			TasaPorMinuto: tramo.TasaPorMinuto,
+		})
+	}
+	for _, grupo := range c.Grupos {
+		patron.Grupos = append(patron.Grupos, model.PesoGrupo{Tamano: grupo.Tamano, Peso: grupo.Peso})
+	}
+
+	return patron
+}
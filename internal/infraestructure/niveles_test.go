@@ -0,0 +1,200 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDespensaConfigValidar(t *testing.T) {
+	ingredientes := []IngredienteConfig{{Nombre: "Carne", Capacidad: 3}, {Nombre: "Pan", Capacidad: 5}}
+
+	casos := []struct {
+		nombre     string
+		recetas    []RecetaConfig
+		reposicion map[string]int
+		error      string // Parte del mensaje esperado ("" = válida)
+	}{
+		{
+			nombre:  "recetas dentro de la capacidad",
+			recetas: []RecetaConfig{{Nombre: "Hamburguesa", Ingredientes: map[string]int{"Carne": 3, "Pan": 2}}},
+		},
+		{
+			nombre:  "ingrediente desconocido",
+			recetas: []RecetaConfig{{Nombre: "Ensalada", Ingredientes: map[string]int{"Lechuga": 1}}},
+			error:   "desconocido",
+		},
+		{
+			nombre:  "pide más que la capacidad",
+			recetas: []RecetaConfig{{Nombre: "Doble", Ingredientes: map[string]int{"Carne": 4}}},
+			error:   "pide 4",
+		},
+		{
+			nombre:     "reposición de un ingrediente desconocido",
+			reposicion: map[string]int{"Queso": 2},
+			error:      "reposición",
+		},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			config := DespensaConfig{
+				Ingredientes: ingredientes,
+				Recetas:      caso.recetas,
+				Reposicion:   ReposicionConfig{Cantidades: caso.reposicion},
+			}
+			err := config.Validar()
+			if caso.error == "" {
+				if err != nil {
+					t.Fatalf("se esperaba válida: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), caso.error) {
+				t.Fatalf("error %v, se esperaba uno con %q", err, caso.error)
+			}
+		})
+	}
+}
+
+func TestValidarEquipos(t *testing.T) {
+	equipos := []EquipoConfig{{Nombre: "Horno", Capacidad: 2}}
+	recetaConHorno := RecetaConfig{Nombre: "Pizza", Equipos: map[string]int{"Horno": 1}}
+	etapa := EtapaConfig{Nombre: "Coccion", Equipos: map[string]int{"Horno": 1}}
+
+	casos := []struct {
+		nombre string
+		config NivelConfig
+		error  string // Parte del mensaje esperado ("" = válido)
+	}{
+		{
+			nombre: "receta con equipos sin pipeline",
+			config: NivelConfig{Equipos: equipos, Despensa: DespensaConfig{Recetas: []RecetaConfig{recetaConHorno}}},
+		},
+		{
+			nombre: "etapa con equipos",
+			config: NivelConfig{Equipos: equipos, Etapas: []EtapaConfig{etapa}},
+		},
+		{
+			nombre: "receta con equipos y pipeline",
+			config: NivelConfig{
+				Equipos:  equipos,
+				Etapas:   []EtapaConfig{etapa},
+				Despensa: DespensaConfig{Recetas: []RecetaConfig{recetaConHorno}},
+			},
+			error: "en cada etapa",
+		},
+		{
+			nombre: "equipo desconocido",
+			config: NivelConfig{Etapas: []EtapaConfig{etapa}},
+			error:  "desconocido",
+		},
+		{
+			nombre: "pide más que la capacidad",
+			config: NivelConfig{Equipos: equipos, Etapas: []EtapaConfig{{Nombre: "Coccion", Equipos: map[string]int{"Horno": 3}}}},
+			error:  "solo hay 2",
+		},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			err := caso.config.validarEquipos()
+			if caso.error == "" {
+				if err != nil {
+					t.Fatalf("se esperaba válido: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), caso.error) {
+				t.Fatalf("error %v, se esperaba uno con %q", err, caso.error)
+			}
+		})
+	}
+}
+
+func TestLlegadasConfigValidar(t *testing.T) {
+	rafaga := RafagaConfig{PeriodoS: 40, Grupos: 3}
+
+	casos := []struct {
+		nombre   string
+		llegadas LlegadasConfig
+		error    string // Parte del mensaje esperado ("" = válida)
+	}{
+		{nombre: "curva por defecto", llegadas: LlegadasConfig{}},
+		{nombre: "poisson", llegadas: LlegadasConfig{Tipo: "poisson", TasaPorMinuto: 6}},
+		{nombre: "rafagas", llegadas: LlegadasConfig{Tipo: "rafagas", TasaPorMinuto: 6, Rafaga: rafaga}},
+		{nombre: "tipo desconocido", llegadas: LlegadasConfig{Tipo: "lluvia"}, error: "desconocido"},
+		{
+			nombre:   "tasa negativa",
+			llegadas: LlegadasConfig{Tipo: "poisson", TasaPorMinuto: -1},
+			error:    "negativa",
+		},
+		{
+			nombre:   "tramo con tasa negativa",
+			llegadas: LlegadasConfig{Tipo: "programada", Tramos: []TramoLlegadaConfig{{Nombre: "Pico", TasaPorMinuto: -2}}},
+			error:    "Pico",
+		},
+		{
+			nombre:   "ráfaga sin período",
+			llegadas: LlegadasConfig{Tipo: "rafagas", Rafaga: RafagaConfig{Grupos: 3}},
+			error:    "periodo_s",
+		},
+		{
+			nombre:   "ráfaga sin grupos",
+			llegadas: LlegadasConfig{Tipo: "rafagas", Rafaga: RafagaConfig{PeriodoS: 40, Grupos: -1}},
+			error:    "grupos",
+		},
+		{
+			nombre:   "grupo sin personas",
+			llegadas: LlegadasConfig{Grupos: []PesoGrupoConfig{{Tamano: 0, Peso: 1}}},
+			error:    "no es válido",
+		},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			err := caso.llegadas.Validar()
+			if caso.error == "" {
+				if err != nil {
+					t.Fatalf("se esperaba válida: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), caso.error) {
+				t.Fatalf("error %v, se esperaba uno con %q", err, caso.error)
+			}
+		})
+	}
+}
+
+func TestCargarNivelValidaMesasYLlegadas(t *testing.T) {
+	casos := []struct {
+		nombre string
+		json   string
+		error  string // Parte del mensaje esperado ("" = válido)
+	}{
+		{nombre: "mesas por defecto", json: `{"nombre": "Prueba"}`},
+		{nombre: "mesas negativas", json: `{"mesas": -2}`, error: "mesas"},
+		{
+			nombre: "ráfaga sin período",
+			json:   `{"llegadas": {"tipo": "rafagas", "rafaga": {"grupos": 2}}}`,
+			error:  "periodo_s",
+		},
+	}
+	for _, caso := range casos {
+		t.Run(caso.nombre, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "nivel.json")
+			if err := os.WriteFile(path, []byte(caso.json), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := CargarNivel(path)
+			if caso.error == "" {
+				if err != nil {
+					t.Fatalf("se esperaba válido: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), caso.error) {
+				t.Fatalf("error %v, se esperaba uno con %q", err, caso.error)
+			}
+		})
+	}
+}